@@ -5,14 +5,18 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
-	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/auburnhacks/sponsor/pkg/auth"
+	"github.com/auburnhacks/sponsor/pkg/blob"
 	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/auburnhacks/sponsor/pkg/mail"
 	"github.com/auburnhacks/sponsor/pkg/participant"
 	"github.com/auburnhacks/sponsor/pkg/server"
+	"github.com/auburnhacks/sponsor/pkg/session"
+	"github.com/auburnhacks/sponsor/pkg/sponsor"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	log "github.com/sirupsen/logrus"
@@ -29,6 +33,28 @@ var (
 	quillMongoURI   *string
 	resumesMongoURI *string
 	syncDuration    *time.Duration
+	fullSyncEvery   *time.Duration
+	maxDeleteShare  *float64
+	quillConfig     *string
+	sourceKind      *string
+	sourceURI       *string
+	resumeWorkers   *int
+	resumeTimeout   *time.Duration
+	resumeRetries   *int
+	resumeCacheDir  *string
+	tiersConfig     *string
+	eventStart      *string
+	accessTokenTTL  *time.Duration
+	refreshTokenTTL *time.Duration
+	jwtKey          *string
+	jwtRetiredKeys  *string
+	jwtRotation     *time.Duration
+	mailer          *string
+	mailFile        *string
+	mailFrom        *string
+	smtpAddr        *string
+	smtpUsername    *string
+	smtpPassword    *string
 )
 
 func init() {
@@ -61,9 +87,32 @@ func init() {
 	quillMongoURI = flag.String("quill_db_uri", "mongodb://localhost:27017/quill", "database URI for quill")
 	resumesMongoURI = flag.String("resumes_db_uri", "mongodb://localhost:27017/resumes", "database uri for resumes")
 	syncDuration = flag.Duration("sync_duration", 1*time.Minute, "sleep duration every sync period")
+	quillConfig = flag.String("quill_config", "", "json file with the databases, collections and field mapping used to sync from quill")
+	sourceKind = flag.String("participant_source", "quill", "source of the participants, one of quill, file or http")
+	sourceURI = flag.String("participant_source_uri", "", "path of the csv/json file or url of the http endpoint for the file and http sources")
+	fullSyncEvery = flag.Duration("full_sync_duration", 1*time.Hour, "maximum duration between full syncs that catch deleted participants")
+	maxDeleteShare = flag.Float64("max_sync_delete_share", 0.5, "largest share of the participants that a full sync may delete, nothing is deleted when more are missing from the source")
+	resumeWorkers = flag.Int("resume_workers", 8, "maximum number of resumes that are downloaded at the same time")
+	resumeTimeout = flag.Duration("resume_timeout", 30*time.Second, "timeout for a single attempt at downloading a resume")
+	resumeRetries = flag.Int("resume_retries", 3, "number of times a failed resume download is retried")
+	tiersConfig = flag.String("tiers_config", "", "json file that maps every sponsorship tier to its entitlements")
+	eventStart = flag.String("event_start", "", "start of the event in RFC3339, sponsors without pre-event access cannot see participants before it")
+	accessTokenTTL = flag.Duration("access_token_ttl", 15*time.Minute, "how long an access token is valid before it has to be refreshed")
+	refreshTokenTTL = flag.Duration("refresh_token_ttl", 30*24*time.Hour, "how long a refresh token is valid before the user has to log in again")
+	jwtKey = flag.String("jwt_key", "file:jwt_key_dev#dev", "key the tokens are signed with as file:<path> or env:<variable> followed by #<kid>, PEM RSA and EC keys use RS256 and ES256 and default to a kid derived from the public key, any other key is an HS256 secret and needs a kid")
+	jwtRetiredKeys = flag.String("jwt_retired_keys", "", "comma separated keys that signed tokens before jwt_key, in the same format as jwt_key")
+	jwtRotation = flag.Duration("jwt_rotation_window", 24*time.Hour, "how long the tokens signed with a retired key are still accepted after startup")
+	mailer = flag.String("mailer", "log", "how emails are delivered, one of log, file or smtp")
+	mailFile = flag.String("mail_file", "mail.txt", "file the emails are appended to by the file mailer")
+	mailFrom = flag.String("mail_from", "sponsors@auburnhacks.com", "sender address of the emails sent through smtp")
+	smtpAddr = flag.String("smtp_addr", "localhost:587", "host:port of the smtp server")
+	smtpUsername = flag.String("smtp_username", "", "username for the smtp server, no authentication is used when empty")
+	smtpPassword = flag.String("smtp_password", "", "password for the smtp server")
+	resumeCacheDir = flag.String("resume_cache_dir", "", "directory the resumes are mirrored to after every sync, resumes are not mirrored when empty")
 
 	server.GatewayAddr = flag.String("gateway_addr", "localhost:8080", "grpc gateway listen addr")
 	server.RPCAddr = flag.String("rpc_addr", "localhost:10000", "grpc server listening addr")
+	server.AppURL = flag.String("app_url", "http://localhost:4200", "url of the web app that is linked to in emails")
 
 	flag.Parse()
 }
@@ -88,15 +137,43 @@ func main() {
 	// Initialize the global database connection with the opened connection
 	db.Conn = dbConn
 
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 
 	// Run all database migrations
 	log.Info("running database migrations")
 	if err := db.Migrate(quit); err != nil {
 		log.Fatalf("error migrating database: %v", err)
 	}
+	participant.MaxDeleteShare = *maxDeleteShare
+	participant.DefaultDownloader = participant.NewDownloader(*resumeWorkers,
+		*resumeTimeout, *resumeRetries)
+	if len(*resumeCacheDir) > 0 {
+		store, err := blob.NewFSStore(*resumeCacheDir)
+		if err != nil {
+			log.Fatalf("error creating resume cache: %v", err)
+		}
+		participant.ResumeStore = store
+	}
+	if len(*tiersConfig) > 0 {
+		if err := sponsor.LoadTiers(*tiersConfig); err != nil {
+			log.Fatalf("error loading sponsorship tiers: %v", err)
+		}
+	}
+	if len(*eventStart) > 0 {
+		sponsor.EventStart, err = time.Parse(time.RFC3339, *eventStart)
+		if err != nil {
+			log.Fatalf("error parsing event start: %v", err)
+		}
+	}
+	mail.DefaultMailer, err = newMailer()
+	if err != nil {
+		log.Fatalf("error creating mailer: %v", err)
+	}
+	auth.AccessTokenTTL = *accessTokenTTL
+	auth.Revocations = session.Revocations{}
+	session.RefreshTokenTTL = *refreshTokenTTL
 	// Read the signing key for JWT tokens
-	key, err := auth.LoadJWTKey(filepath.Join(".", "jwt_key_dev"))
+	key, err := loadKeys()
 	if err != nil {
 		log.Fatalf("error loading jwt key: %v", err)
 	}
@@ -110,7 +187,11 @@ func main() {
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
 	pollerQuit := make(chan struct{})
 	// Start syncing the participants from the external database
-	err = participant.Sync(*quillMongoURI, *resumesMongoURI, *syncDuration, pollerQuit)
+	src, err := newSource()
+	if err != nil {
+		log.Fatalf("error creating participant source: %v", err)
+	}
+	err = participant.Sync(src, *syncDuration, *fullSyncEvery, pollerQuit)
 	if err != nil {
 		log.Errorf("error while syncing from external db: %v", err)
 		pollerQuit <- struct{}{}
@@ -132,3 +213,72 @@ func main() {
 	}
 	os.Exit(0)
 }
+
+// loadKeys loads the keyring that signs and verifies the tokens and returns
+// the material of the active key, which also signs the resume URLs
+func loadKeys() ([]byte, error) {
+	source, kid := auth.SplitKeySource(*jwtKey)
+	bb, err := auth.LoadKey(source)
+	if err != nil {
+		return nil, err
+	}
+	active, err := auth.ParseKey(bb, kid)
+	if err != nil {
+		return nil, err
+	}
+	auth.Keys, err = auth.NewKeyring(active)
+	if err != nil {
+		return nil, err
+	}
+	until := time.Now().Add(*jwtRotation)
+	for _, source := range strings.Split(*jwtRetiredKeys, ",") {
+		if len(strings.TrimSpace(source)) == 0 {
+			continue
+		}
+		source, kid := auth.SplitKeySource(strings.TrimSpace(source))
+		retiredBB, err := auth.LoadKey(source)
+		if err != nil {
+			return nil, err
+		}
+		retired, err := auth.ParseKey(retiredBB, kid)
+		if err != nil {
+			return nil, err
+		}
+		auth.Keys.Retire(retired, until)
+	}
+	return bb, nil
+}
+
+// newMailer returns the mailer selected by the flags
+func newMailer() (mail.Mailer, error) {
+	switch *mailer {
+	case "log":
+		return mail.LogMailer{}, nil
+	case "file":
+		return mail.NewFileMailer(*mailFile), nil
+	case "smtp":
+		return mail.NewSMTPMailer(*smtpAddr, *smtpUsername, *smtpPassword, *mailFrom)
+	}
+	return nil, fmt.Errorf("unknown mailer: %s", *mailer)
+}
+
+// newSource returns the participant source selected by the flags
+func newSource() (participant.Source, error) {
+	switch *sourceKind {
+	case "quill":
+		cfg := participant.DefaultQuillConfig()
+		if len(*quillConfig) > 0 {
+			var err error
+			cfg, err = participant.LoadQuillConfig(*quillConfig)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return participant.NewQuillSource(*quillMongoURI, *resumesMongoURI, cfg)
+	case "file":
+		return participant.NewFileSource(*sourceURI)
+	case "http":
+		return participant.NewHTTPSource(*sourceURI)
+	}
+	return nil, fmt.Errorf("unknown participant source: %s", *sourceKind)
+}
@@ -18,6 +18,7 @@ require (
 	github.com/gotestyourself/gotestyourself v2.1.0+incompatible // indirect
 	github.com/grpc-ecosystem/grpc-gateway v1.6.4
 	github.com/jmoiron/sqlx v1.2.0
+	github.com/ledongthuc/pdf v0.0.0-20220302134840-0c2507a12d80
 	github.com/lib/pq v1.0.0
 	github.com/mongodb/mongo-go-driver v0.2.0
 	github.com/opencontainers/go-digest v1.0.0-rc1 // indirect
@@ -0,0 +1,2 @@
+DROP TABLE company_resume_downloads;
+ALTER TABLE company DROP COLUMN tier;
\ No newline at end of file
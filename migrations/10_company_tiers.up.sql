@@ -0,0 +1,19 @@
+BEGIN;
+-- the existing companies keep the full access they had before tiers, the
+-- default is dropped right away so that a new company never gets a tier it
+-- was not given explicitly
+ALTER TABLE company ADD COLUMN tier TEXT NOT NULL DEFAULT 'gold';
+ALTER TABLE company ALTER COLUMN tier DROP DEFAULT;
+
+-- company_resume_downloads has every resume that a company downloaded, it is
+-- used to enforce the cap on the number of resumes of a tier. The resumes are
+-- keyed on the external id of the participant which survives a sync so that
+-- deleting and syncing a participant again does not give back any quota
+CREATE TABLE IF NOT EXISTS company_resume_downloads (
+    company_id UUID NOT NULL REFERENCES company(id),
+    participant_key TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL,
+    PRIMARY KEY (company_id, participant_key)
+);
+
+COMMIT;
@@ -0,0 +1,2 @@
+UPDATE admins SET acl = CASE WHEN acl LIKE '%super-admin%' OR acl LIKE '%organizer%' THEN 'read,update' ELSE 'read' END;
+UPDATE sponsors SET acl = CASE WHEN acl LIKE '%sponsor-manager%' THEN 'read,update' ELSE 'read' END;
\ No newline at end of file
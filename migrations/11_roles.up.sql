@@ -0,0 +1,14 @@
+BEGIN;
+-- the acl is a comma separated list of roles instead of the read and update
+-- claims, the users that could update keep being able to manage everything
+UPDATE admins SET acl = CASE
+    WHEN 'update' = ANY(string_to_array(acl, ',')) THEN 'super-admin'
+    ELSE 'read-only'
+END;
+
+UPDATE sponsors SET acl = CASE
+    WHEN 'update' = ANY(string_to_array(acl, ',')) THEN 'sponsor-manager'
+    ELSE 'sponsor-recruiter'
+END;
+
+COMMIT;
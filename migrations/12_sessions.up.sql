@@ -0,0 +1,36 @@
+BEGIN;
+-- refresh_tokens has a row for every refresh token that was issued, only the
+-- sha256 of a token is stored. A token is rotated on every use and all the
+-- tokens issued from the same login share a family_id so that the whole
+-- family can be revoked when a used token is presented again
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    token_hash TEXT NOT NULL UNIQUE,
+    family_id UUID NOT NULL,
+    subject_kind TEXT NOT NULL,
+    subject_id UUID NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS refresh_tokens_family ON refresh_tokens (family_id);
+CREATE INDEX IF NOT EXISTS refresh_tokens_subject ON refresh_tokens (subject_id);
+
+-- revoked_tokens are the access tokens that were revoked before they expire,
+-- a row can be removed once the token has expired
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti TEXT PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+-- revoked_subjects revokes every access token of a user that was issued
+-- before revoked_before
+CREATE TABLE IF NOT EXISTS revoked_subjects (
+    subject_id TEXT PRIMARY KEY,
+    revoked_before TIMESTAMP NOT NULL
+);
+
+COMMIT;
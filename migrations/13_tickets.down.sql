@@ -0,0 +1 @@
+DROP TABLE tickets;
\ No newline at end of file
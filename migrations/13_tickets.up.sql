@@ -0,0 +1,17 @@
+BEGIN;
+-- tickets are the single use tokens that are emailed to a user to accept an
+-- invite or to reset a password, only the sha256 of a token is stored
+CREATE TABLE IF NOT EXISTS tickets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    token_hash TEXT NOT NULL UNIQUE,
+    purpose TEXT NOT NULL,
+    subject_kind TEXT NOT NULL,
+    subject_id UUID NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS tickets_subject ON tickets (subject_id);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+ALTER TABLE participant_notes DROP CONSTRAINT participant_notes_rating_check;
+UPDATE participant_notes SET rating = 0 WHERE rating IS NULL;
+ALTER TABLE participant_notes ALTER COLUMN rating SET NOT NULL;
+ALTER TABLE participant_notes ADD CONSTRAINT participant_notes_rating_check
+    CHECK (rating >= 0 AND rating <= 5);
+COMMIT;
\ No newline at end of file
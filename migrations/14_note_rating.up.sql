@@ -0,0 +1,10 @@
+BEGIN;
+-- a note without a rating has a NULL rating instead of 0 so that a rating is
+-- always between 1 and 5
+ALTER TABLE participant_notes ALTER COLUMN rating DROP NOT NULL;
+ALTER TABLE participant_notes DROP CONSTRAINT IF EXISTS participant_notes_rating_check;
+UPDATE participant_notes SET rating = NULL WHERE rating = 0;
+ALTER TABLE participant_notes ADD CONSTRAINT participant_notes_rating_check
+    CHECK (rating BETWEEN 1 AND 5);
+
+COMMIT;
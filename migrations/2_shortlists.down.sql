@@ -0,0 +1 @@
+DROP TABLE shortlists;
\ No newline at end of file
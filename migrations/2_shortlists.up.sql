@@ -0,0 +1,12 @@
+BEGIN;
+CREATE TABLE IF NOT EXISTS shortlists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES company(id),
+    sponsor_id UUID NOT NULL REFERENCES sponsors(id),
+    participant_id UUID NOT NULL REFERENCES participants(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP DEFAULT NOW() NOT NULL,
+    UNIQUE (company_id, participant_id)
+);
+
+COMMIT;
@@ -0,0 +1 @@
+DROP TABLE participant_notes;
\ No newline at end of file
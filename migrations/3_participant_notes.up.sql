@@ -0,0 +1,16 @@
+BEGIN;
+CREATE TABLE IF NOT EXISTS participant_notes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    participant_id UUID NOT NULL REFERENCES participants(id) ON DELETE CASCADE,
+    company_id UUID NOT NULL REFERENCES company(id),
+    sponsor_id UUID NOT NULL REFERENCES sponsors(id),
+    body TEXT NOT NULL,
+    rating INT NOT NULL CHECK (rating >= 0 AND rating <= 5),
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS participant_notes_company_participant
+ON participant_notes (company_id, participant_id);
+
+COMMIT;
@@ -0,0 +1 @@
+ALTER TABLE participants DROP COLUMN external_id;
\ No newline at end of file
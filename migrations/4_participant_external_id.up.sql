@@ -0,0 +1,9 @@
+BEGIN;
+-- external_id is NULL for the participants that were synced before it existed,
+-- the next sync fills it in by matching their email so that the shortlists and
+-- notes of the existing participants are kept. A unique constraint allows any
+-- number of NULLs
+ALTER TABLE participants ADD COLUMN external_id TEXT;
+ALTER TABLE participants ADD CONSTRAINT participants_external_id_key UNIQUE (external_id);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+CREATE TABLE IF NOT EXISTS sync_state (
+    source TEXT PRIMARY KEY,
+    watermark BIGINT NOT NULL DEFAULT 0,
+    last_full_sync TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL,
+    updated_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+COMMIT;
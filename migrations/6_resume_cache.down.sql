@@ -0,0 +1,5 @@
+ALTER TABLE participants
+    DROP COLUMN resume_hash,
+    DROP COLUMN resume_size,
+    DROP COLUMN resume_content_type,
+    DROP COLUMN resume_cached_url;
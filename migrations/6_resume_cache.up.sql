@@ -0,0 +1,11 @@
+BEGIN;
+-- resume_hash is the key of the cached copy of resume_url in the blob store,
+-- resume_cached_url is the url the cached copy was downloaded from so that
+-- a resume is downloaded again when its url changes
+ALTER TABLE participants
+    ADD COLUMN resume_hash TEXT NOT NULL DEFAULT '',
+    ADD COLUMN resume_size BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN resume_content_type TEXT NOT NULL DEFAULT '',
+    ADD COLUMN resume_cached_url TEXT NOT NULL DEFAULT '';
+
+COMMIT;
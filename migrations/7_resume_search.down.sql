@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS participants_resume_tsv_idx;
+DROP TRIGGER IF EXISTS update_participants_resume_tsv ON participants;
+DROP FUNCTION IF EXISTS update_resume_tsv();
+ALTER TABLE participants DROP COLUMN resume_text, DROP COLUMN resume_tsv;
\ No newline at end of file
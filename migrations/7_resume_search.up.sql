@@ -0,0 +1,31 @@
+BEGIN;
+-- resume_text is the text extracted from the resume of a participant,
+-- resume_tsv is kept up to date by a trigger so that a participant is
+-- searchable by its name, major and university as well
+ALTER TABLE participants
+    ADD COLUMN resume_text TEXT NOT NULL DEFAULT '',
+    ADD COLUMN resume_tsv TSVECTOR;
+
+CREATE OR REPLACE FUNCTION update_resume_tsv()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.resume_tsv =
+        setweight(to_tsvector('english', coalesce(NEW.name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(NEW.major, '') || ' ' ||
+            coalesce(NEW.university, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(NEW.resume_text, '')), 'C');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER update_participants_resume_tsv BEFORE INSERT OR UPDATE ON participants
+FOR EACH ROW EXECUTE PROCEDURE update_resume_tsv();
+
+-- fills resume_tsv for the existing participants, the resumes that were
+-- already mirrored have no text yet so they are mirrored again by the next
+-- sync which extracts their text
+UPDATE participants SET resume_text = resume_text, resume_cached_url = '';
+
+CREATE INDEX participants_resume_tsv_idx ON participants USING GIN (resume_tsv);
+
+COMMIT;
@@ -0,0 +1 @@
+DROP TABLE resume_views;
\ No newline at end of file
@@ -0,0 +1,14 @@
+BEGIN;
+-- resume_views is an audit log of every time a resume was viewed, viewer_id
+-- is the ID of the admin or sponsor that viewed the resume
+CREATE TABLE IF NOT EXISTS resume_views (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    participant_id UUID NOT NULL REFERENCES participants(id) ON DELETE CASCADE,
+    viewer_id UUID NOT NULL,
+    via TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS resume_views_participant ON resume_views (participant_id);
+
+COMMIT;
@@ -0,0 +1,3 @@
+ALTER TABLE admins DROP COLUMN deleted_at;
+ALTER TABLE sponsors DROP COLUMN deleted_at;
+ALTER TABLE company DROP COLUMN deleted_at;
\ No newline at end of file
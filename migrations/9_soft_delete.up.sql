@@ -0,0 +1,9 @@
+BEGIN;
+-- admins, sponsors and companies are never removed from the database so
+-- that the notes and resume views linked to them are kept, a row is deleted
+-- when deleted_at is set
+ALTER TABLE admins ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE sponsors ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE company ADD COLUMN deleted_at TIMESTAMP;
+
+COMMIT;
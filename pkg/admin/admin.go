@@ -3,21 +3,29 @@
 package admin
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
+	"github.com/auburnhacks/sponsor/pkg/auth"
 	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 )
 
 // DefaultACL is a variables that is used for all admins if no ACL list is
 // provided during signup
-var DefaultACL = "read,update"
+var DefaultACL = string(auth.RoleOrganizer)
 
 // ErrInvalidAuth is an error that is returns when there is a failed login attempt
 var ErrInvalidAuth = errors.New("pkg/admin: invalid credentials provided, please try again")
 
+// ErrLastAdmin is an error that is returned when the only admin that is left
+// is being deleted since nobody would be able to manage the system anymore
+var ErrLastAdmin = errors.New("pkg/admin: the last admin cannot be deleted")
+
 // Admin is a struct that is the highest entity in the system
 // It has write and read access to any thing in the database.
 // More fine grained controlled can be given based on the ACL
@@ -30,6 +38,9 @@ type Admin struct {
 	ACL       string    `db:"acl"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
+	// DeletedAt is set when the admin has been deleted, deleted admins are
+	// never returned by any of the functions in this package
+	DeletedAt pq.NullTime `db:"deleted_at"`
 }
 
 // New is a function that returns an instance of admin
@@ -57,42 +68,157 @@ func NewWithACL(name, email, password string, acl string) *Admin {
 	return a
 }
 
-// Save saves the instance of an admin to the database
+// Save saves the instance of an admin to the database, sql.ErrNoRows is
+// returned when the admin does not exist or has been deleted
 func (a *Admin) Save() error {
+	if err := auth.ValidateACL(a.ACL, auth.AdminRoles); err != nil {
+		return err
+	}
 	q := `
 	UPDATE admins 
 	SET name = :name, email = :email, password = :password, acl = :acl
-	WHERE id = :id
+	WHERE id = :id AND deleted_at IS NULL
 	RETURNING id`
 	stmt, err := db.Conn.PrepareNamed(q)
 	if err != nil {
 		return err
 	}
-	_ = stmt.QueryRow(map[string]interface{}{
+	defer stmt.Close()
+	var id string
+	err = stmt.QueryRow(map[string]interface{}{
 		"id":       a.ID,
 		"name":     a.Name,
 		"email":    a.Email,
 		"password": a.Password,
 		"acl":      a.ACL,
-	})
+	}).Scan(&id)
+	if err == sql.ErrNoRows {
+		return err
+	}
+	if err != nil {
+		return errors.Wrap(err, "pkg/admin: error while saving admin")
+	}
 	return nil
 }
 
-// Register is only called once when the admin first signs up
-func (a *Admin) Register() error {
-	// hash password and save it to the database
-	pwdHash, err := bcrypt.GenerateFromPassword([]byte(a.Password), bcrypt.DefaultCost)
+// Delete soft deletes an admin. The admins are locked while the admin is
+// deleted so that two admins deleting each other can not leave the system
+// without any admin
+func (a *Admin) Delete() error {
+	tx, err := db.Conn.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "pkg/admin: error while starting transaction")
+	}
+	defer tx.Rollback()
+	var ids []string
+	err = tx.Select(&ids, `SELECT id FROM admins WHERE deleted_at IS NULL FOR UPDATE`)
+	if err != nil {
+		return errors.Wrap(err, "pkg/admin: error while locking admins")
+	}
+	if len(ids) <= 1 {
+		return ErrLastAdmin
+	}
+	r, err := tx.NamedExec(`
+	UPDATE admins SET deleted_at = NOW()
+	WHERE id = :id AND deleted_at IS NULL`, a)
+	if err != nil {
+		return errors.Wrap(err, "pkg/admin: error while deleting admin")
+	}
+	if n, err := r.RowsAffected(); err != nil || n == 0 {
+		return sql.ErrNoRows
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "pkg/admin: error while committing delete")
+	}
+	return nil
+}
+
+// SetPassword replaces the password of the admin with the hash of the plain
+// text password, the admin still has to be saved
+func (a *Admin) SetPassword(password string) error {
+	pwdHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return err
 	}
 	// update the struct with the secure password
 	a.Password = fmt.Sprintf("%s", pwdHash)
+	return nil
+}
+
+// UpdatePassword sets a new password for the admin with the ID in the
+// transaction, sql.ErrNoRows is returned when there is no such admin
+func UpdatePassword(tx *sqlx.Tx, adminID, password string) error {
+	a := &Admin{ID: adminID}
+	if err := a.SetPassword(password); err != nil {
+		return err
+	}
+	var id string
+	err := tx.Get(&id, `
+	UPDATE admins SET password = $1
+	WHERE id = $2 AND deleted_at IS NULL
+	RETURNING id`, a.Password, a.ID)
+	if err == sql.ErrNoRows {
+		return err
+	}
+	if err != nil {
+		return errors.Wrap(err, "pkg/admin: error while saving password")
+	}
+	return nil
+}
+
+// Register is only called once when the admin first signs up
+func (a *Admin) Register() error {
+	return a.register(db.Conn)
+}
+
+// ErrAdminsExist is returned when the first admin is registered while there
+// already are admins
+var ErrAdminsExist = errors.New("pkg/admin: the first admin has already been created")
+
+// RegisterFirst registers the admin only when there are no admins yet. The
+// admins are locked while they are counted so that two concurrent calls can
+// not both register a first admin
+func (a *Admin) RegisterFirst() error {
+	tx, err := db.Conn.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "pkg/admin: error while starting transaction")
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`LOCK TABLE admins IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return errors.Wrap(err, "pkg/admin: error while locking admins")
+	}
+	var n int
+	err = tx.Get(&n, `SELECT COUNT(*) FROM admins WHERE deleted_at IS NULL`)
+	if err != nil {
+		return errors.Wrap(err, "pkg/admin: error while counting admins")
+	}
+	if n > 0 {
+		return ErrAdminsExist
+	}
+	if err := a.register(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "pkg/admin: error while committing admin")
+	}
+	return nil
+}
+
+// register inserts the admin with the database connection or transaction
+func (a *Admin) register(e namedPreparer) error {
+	if err := auth.ValidateACL(a.ACL, auth.AdminRoles); err != nil {
+		return err
+	}
+	// hash password and save it to the database
+	if err := a.SetPassword(a.Password); err != nil {
+		return err
+	}
 	query := `
 	INSERT INTO admins
 	(name, email, password, acl)
 	VALUES(:name, :email, :password, :acl)
 	RETURNING id`
-	stmt, err := db.Conn.PrepareNamed(query)
+	stmt, err := e.PrepareNamed(query)
 	if err != nil {
 		return err
 	}
@@ -111,6 +237,21 @@ func (a *Admin) Register() error {
 	return nil
 }
 
+// namedPreparer is a database connection or a transaction
+type namedPreparer interface {
+	PrepareNamed(query string) (*sqlx.NamedStmt, error)
+}
+
+// Count returns the number of admins that have not been deleted
+func Count() (int, error) {
+	var n int
+	err := db.Conn.Get(&n, `SELECT COUNT(*) FROM admins WHERE deleted_at IS NULL`)
+	if err != nil {
+		return 0, errors.Wrap(err, "pkg/admin: error while counting admins")
+	}
+	return n, nil
+}
+
 // Login is a function that returns an instance of an admin is
 // successcful or returns an error if there was some of error
 func Login(email, password string) (*Admin, error) {
@@ -128,7 +269,7 @@ func Login(email, password string) (*Admin, error) {
 
 // ByID is a function that gets an admin from the given ID
 func ByID(adminID string) (*Admin, error) {
-	query := `SELECT * FROM admins WHERE id=:id`
+	query := `SELECT * FROM admins WHERE id=:id AND deleted_at IS NULL`
 	stmt, err := db.Conn.PrepareNamed(query)
 	if err != nil {
 		return nil, err
@@ -145,7 +286,7 @@ func ByID(adminID string) (*Admin, error) {
 
 // ByEmail is a function that gets an admin from the given email
 func ByEmail(adminEmail string) (*Admin, error) {
-	query := `SELECT * FROM admins WHERE email=:email`
+	query := `SELECT * FROM admins WHERE email=:email AND deleted_at IS NULL`
 	stmt, err := db.Conn.PrepareNamed(query)
 	if err != nil {
 		return nil, err
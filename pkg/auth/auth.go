@@ -5,12 +5,11 @@ package auth
 import (
 	"context"
 	"errors"
-	"io/ioutil"
-	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -18,66 +17,166 @@ var (
 	// ErrUnauthorized is an error that is used when a user is requesting an
 	// unauthorized claim in the system
 	ErrUnauthorized = errors.New("auth: user unauthoized to perform this action")
+	// ErrInvalidClaims is returned when a token was not issued by this server
+	// or does not describe a valid principal
+	ErrInvalidClaims = errors.New("auth: invalid token claims")
+	// ErrTokenRevoked is returned when a token has been revoked before it
+	// expired
+	ErrTokenRevoked = errors.New("auth: token has been revoked")
+)
+
+// AccessTokenTTL is how long an access token is valid, a new one is issued
+// with a refresh token once it has expired
+var AccessTokenTTL = 15 * time.Minute
+
+// RevocationList keeps track of the tokens that were revoked before they
+// expired
+type RevocationList interface {
+	// Revoked reports whether the token with the given jti that was issued to
+	// the subject at issuedAt has been revoked
+	Revoked(jti, subjectID string, issuedAt time.Time) (bool, error)
+}
+
+// Revocations is the revocation list checked by FromContext for every token,
+// no token is ever revoked when it is nil
+var Revocations RevocationList
+
+const (
+	tokenIssuer   = "sponsor_auburnhacks"
+	tokenAudience = "sponsor_api"
 )
 
 // Claims is an interface that describes the various cliams that are
 // allowed in the auth package
 type Claims interface {
-	Claim(resource string) error
+	// Claim returns ErrUnauthorized when none of the roles of the user
+	// grants the permission
+	Claim(p Permission) error
+	// ID returns the ID of the admin or sponsor the claims were issued to
+	ID() string
+	// Principal returns the user that the claims were issued to
+	Principal() *Principal
+	// TokenID returns the unique ID (jti) of the token
+	TokenID() string
+	// Expiry returns the time at which the token expires
+	Expiry() time.Time
 	jwt.Claims
 }
 
-// LoadJWTKey loads a JWT key from a given file path
-func LoadJWTKey(filename string) ([]byte, error) {
-	bb, err := ioutil.ReadFile(filename)
-	if err != nil {
-		return nil, err
-	}
-	return bb, nil
-}
-
-// HasAccessToResource is a function that determines whether an
-// ACL has access to the requesting claim
-// NOTE: ACL is has to be a comma separated string
-func hasAccessToResource(ACL string, claim string) error {
-	acls := strings.Split(ACL, ",")
-	for _, acl := range acls {
-		if claim == acl {
-			return nil
-		}
+// hasAccessToResource is a function that determines whether an
+// ACL grants the requested permission
+// NOTE: ACL is has to be a comma separated list of roles
+func hasAccessToResource(ACL string, p Permission) error {
+	if !HasPermission(ACL, p) {
+		return ErrUnauthorized
 	}
-	return ErrUnauthorized
+	return nil
 }
 
 // AdminClaims is a struct that represents the structure
 // of the JWT token cliams. It implements Claims interface
 // from the JWT package
 type AdminClaims struct {
-	ACL string `json:"acl"`
+	ACL  string `json:"acl"`
+	Kind Kind   `json:"kind"`
+	// CompanyID is the company of a sponsor, it is empty for admins
+	CompanyID string `json:"company_id,omitempty"`
+	// Tier is the sponsorship tier of the company of a sponsor when the
+	// token was issued, it is empty for admins
+	Tier string `json:"tier,omitempty"`
 	jwt.StandardClaims
 }
 
 // Claim is a function that returns an error if the requested
-// resource is not present in the acl
-func (c *AdminClaims) Claim(resource string) error {
-	return hasAccessToResource(c.ACL, resource)
+// permission is not granted by any of the roles in the acl
+func (c *AdminClaims) Claim(p Permission) error {
+	return hasAccessToResource(c.ACL, p)
+}
+
+// ID returns the ID of the user that the claims were issued to
+func (c *AdminClaims) ID() string {
+	return c.Subject
+}
+
+// TokenID returns the unique ID (jti) of the token
+func (c *AdminClaims) TokenID() string {
+	return c.Id
+}
+
+// Expiry returns the time at which the token expires
+func (c *AdminClaims) Expiry() time.Time {
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+// Principal returns the user that the claims were issued to
+func (c *AdminClaims) Principal() *Principal {
+	return &Principal{
+		Kind:      c.Kind,
+		SubjectID: c.Subject,
+		CompanyID: c.CompanyID,
+		Roles:     Roles(c.ACL),
+	}
+}
+
+// Valid checks the expiry of the claims and that they were issued by this
+// server to a valid principal
+func (c *AdminClaims) Valid() error {
+	if err := c.StandardClaims.Valid(); err != nil {
+		return err
+	}
+	if !c.VerifyIssuer(tokenIssuer, true) || !c.VerifyAudience(tokenAudience, true) {
+		return ErrInvalidClaims
+	}
+	if len(c.Subject) == 0 || len(c.Id) == 0 {
+		return ErrInvalidClaims
+	}
+	// the roles are checked as well so that a sponsor token can never carry
+	// the roles of an admin
+	switch c.Kind {
+	case KindAdmin:
+		if len(c.CompanyID) > 0 || ValidateACL(c.ACL, AdminRoles) != nil {
+			return ErrInvalidClaims
+		}
+	case KindSponsor:
+		if len(c.CompanyID) == 0 || ValidateACL(c.ACL, SponsorRoles) != nil {
+			return ErrInvalidClaims
+		}
+	default:
+		return ErrInvalidClaims
+	}
+	return nil
 }
 
 // newAdminClaims returns a instance of the admin claims from the input parameters
-func newAdminClaims(adminID, issuer, acl string, issuedAt int64, expiresAt int64) *AdminClaims {
+func newAdminClaims(kind Kind, subjectID, acl string, issuedAt int64, expiresAt int64) *AdminClaims {
 	ac := new(AdminClaims)
 	ac.ACL = acl
-	ac.Id = adminID
+	ac.Kind = kind
+	ac.Id = uuid.New().String()
+	ac.Subject = subjectID
+	ac.Issuer = tokenIssuer
+	ac.Audience = tokenAudience
 	ac.IssuedAt = issuedAt
 	ac.ExpiresAt = expiresAt
 	return ac
 }
 
-// New returns a struct that implements the Claims interface
-func New(id, acl string) Claims {
+// NewAdmin returns a struct that implements the Claims interface for an admin
+func NewAdmin(id, acl string) Claims {
+	issuedAt := time.Now().Unix()
+	expiresAt := time.Now().Add(AccessTokenTTL).Unix()
+	return newAdminClaims(KindAdmin, id, acl, issuedAt, expiresAt)
+}
+
+// NewSponsor returns a struct that implements the Claims interface for a
+// sponsor of a company in the given sponsorship tier
+func NewSponsor(id, companyID, acl, tier string) Claims {
 	issuedAt := time.Now().Unix()
-	expiresAt := time.Now().AddDate(0, 0, 30).Unix()
-	return newAdminClaims(id, "sponsor_auburnhacks", acl, issuedAt, expiresAt)
+	expiresAt := time.Now().Add(AccessTokenTTL).Unix()
+	cl := newAdminClaims(KindSponsor, id, acl, issuedAt, expiresAt)
+	cl.CompanyID = companyID
+	cl.Tier = tier
+	return cl
 }
 
 // FromContext returns the cliams madde by the user based on the request context
@@ -94,17 +193,21 @@ func FromContext(ctx context.Context) (Claims, error) {
 	if len(bearerToken) != 2 {
 		return nil, errors.New("utils: token not found")
 	}
-	token, err := jwt.ParseWithClaims(bearerToken[1], &AdminClaims{},
-		func(token *jwt.Token) (interface{}, error) {
-			return LoadJWTKey(filepath.Join(".", "jwt_key_dev"))
-		},
-	)
+	if Keys == nil {
+		return nil, errors.New("auth: no keys to verify the token with")
+	}
+	cl, err := Keys.Parse(bearerToken[1])
 	if err != nil {
 		return nil, err
 	}
-	cl, ok := token.Claims.(*AdminClaims)
-	if !ok {
-		return nil, errors.New("auth: error converting to admin claims")
+	if Revocations != nil {
+		revoked, err := Revocations.Revoked(cl.Id, cl.Subject, time.Unix(cl.IssuedAt, 0))
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, ErrTokenRevoked
+		}
 	}
 	return cl, nil
 }
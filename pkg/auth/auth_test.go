@@ -0,0 +1,33 @@
+package auth
+
+import "testing"
+
+func TestClaimsValid(t *testing.T) {
+	sp := NewSponsor("sponsor-1", "company-1", string(RoleSponsorRecruiter), "gold").(*AdminClaims)
+	if err := sp.Valid(); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	p := sp.Principal()
+	if !p.IsSponsor() || p.SubjectID != "sponsor-1" || p.CompanyID != "company-1" {
+		t.Fatalf("unexpected principal: %+v", p)
+	}
+	if !p.Can(PermResumesRead) || p.Can(PermAdminsRead) {
+		t.Fatalf("unexpected permissions for roles %v", p.Roles)
+	}
+
+	invalid := map[string]*AdminClaims{
+		"other issuer":            NewAdmin("admin-1", string(RoleOrganizer)).(*AdminClaims),
+		"other audience":          NewAdmin("admin-1", string(RoleOrganizer)).(*AdminClaims),
+		"sponsor without company": NewSponsor("sponsor-1", "", string(RoleSponsorRecruiter), "gold").(*AdminClaims),
+		"sponsor with admin role": NewSponsor("sponsor-1", "company-1", string(RoleSuperAdmin), "gold").(*AdminClaims),
+		"no kind":                 NewAdmin("admin-1", string(RoleOrganizer)).(*AdminClaims),
+	}
+	invalid["other issuer"].Issuer = "someone_else"
+	invalid["other audience"].Audience = "other_api"
+	invalid["no kind"].Kind = ""
+	for name, cl := range invalid {
+		if err := cl.Valid(); err != ErrInvalidClaims {
+			t.Errorf("%s: expected invalid claims got: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+var (
+	// ErrUnknownKey is returned when a token was signed with a key that is
+	// not in the keyring or whose rotation window is over
+	ErrUnknownKey = errors.New("auth: token signed with an unknown key")
+	// ErrUnsupportedKey is returned for a key that is neither an RSA key, a
+	// P-256 EC key nor an HMAC secret
+	ErrUnsupportedKey = errors.New("auth: unsupported key")
+	// ErrNoSigningKey is returned when a public key is used to sign tokens
+	ErrNoSigningKey = errors.New("auth: key can only verify tokens")
+	// ErrMissingKeyID is returned for an HMAC secret without an ID, the ID
+	// of a secret is never derived from the secret itself
+	ErrMissingKeyID = errors.New("auth: an HMAC key needs an ID, e.g. file:<path>#<id>")
+)
+
+// Keys is the keyring that every token is signed and verified with
+var Keys *Keyring
+
+// SplitKeySource splits the optional ID of a key from its source, the ID
+// follows a # at the end of the source e.g. env:JWT_KEY#2019-01
+func SplitKeySource(source string) (string, string) {
+	if i := strings.LastIndex(source, "#"); i >= 0 {
+		return source[:i], source[i+1:]
+	}
+	return source, ""
+}
+
+// LoadKey loads the material of a key from its source, the source is either
+// file:<path>, env:<name of an environment variable> or just a path
+func LoadKey(source string) ([]byte, error) {
+	if name := strings.TrimPrefix(source, "env:"); name != source {
+		v := os.Getenv(name)
+		if len(v) == 0 {
+			return nil, fmt.Errorf("auth: environment variable %s is empty", name)
+		}
+		return []byte(v), nil
+	}
+	return ioutil.ReadFile(strings.TrimPrefix(source, "file:"))
+}
+
+// Key is a key that tokens are signed or verified with
+type Key struct {
+	// ID is sent as the kid header of the tokens signed with the key
+	ID     string
+	Method jwt.SigningMethod
+	// sign is nil for a public key
+	sign   interface{}
+	verify interface{}
+}
+
+// ParseKey parses the material of a key with the given ID. PEM encoded RSA
+// and EC keys are used with RS256 and ES256, any other material is an HS256
+// secret. The ID of an RSA or EC key defaults to a hash of its public key, a
+// secret must be given an ID since the kid header of a token is public.
+// Public keys can only be used to verify tokens
+func ParseKey(bb []byte, id string) (*Key, error) {
+	block, _ := pem.Decode(bb)
+	if block == nil {
+		if len(bb) == 0 {
+			return nil, ErrUnsupportedKey
+		}
+		if len(id) == 0 {
+			return nil, ErrMissingKeyID
+		}
+		return &Key{
+			ID:     id,
+			Method: jwt.SigningMethodHS256,
+			sign:   bb,
+			verify: bb,
+		}, nil
+	}
+	var k interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		k, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		k, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		k, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		k, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		k, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, ErrUnsupportedKey
+	}
+	if err != nil {
+		return nil, fmt.Errorf("auth: invalid %s: %v", strings.ToLower(block.Type), err)
+	}
+	var key *Key
+	switch pk := k.(type) {
+	case *rsa.PrivateKey:
+		key, err = newPublicKey(jwt.SigningMethodRS256, pk, &pk.PublicKey)
+	case *rsa.PublicKey:
+		key, err = newPublicKey(jwt.SigningMethodRS256, nil, pk)
+	case *ecdsa.PrivateKey:
+		key, err = newPublicKey(jwt.SigningMethodES256, pk, &pk.PublicKey)
+	case *ecdsa.PublicKey:
+		key, err = newPublicKey(jwt.SigningMethodES256, nil, pk)
+	default:
+		return nil, ErrUnsupportedKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(id) > 0 {
+		key.ID = id
+	}
+	return key, nil
+}
+
+// newPublicKey returns an asymmetric key, its ID is derived from the public
+// key so that every server computes the same ID for the same key
+func newPublicKey(method jwt.SigningMethod, sign, verify interface{}) (*Key, error) {
+	if pub, ok := verify.(*ecdsa.PublicKey); ok && pub.Curve != elliptic.P256() {
+		return nil, ErrUnsupportedKey
+	}
+	der, err := x509.MarshalPKIXPublicKey(verify)
+	if err != nil {
+		return nil, ErrUnsupportedKey
+	}
+	sum := sha256.Sum256(der)
+	return &Key{
+		ID:     hex.EncodeToString(sum[:8]),
+		Method: method,
+		sign:   sign,
+		verify: verify,
+	}, nil
+}
+
+// retiredKey is a key that still verifies tokens until its rotation window
+// is over
+type retiredKey struct {
+	key   *Key
+	until time.Time
+}
+
+// Keyring signs tokens with its active key and verifies tokens signed with
+// the active key or with a retired key during its rotation window
+type Keyring struct {
+	mu      sync.RWMutex
+	active  *Key
+	retired map[string]retiredKey
+}
+
+// NewKeyring returns a keyring that signs tokens with the active key
+func NewKeyring(active *Key) (*Keyring, error) {
+	if active.sign == nil {
+		return nil, ErrNoSigningKey
+	}
+	return &Keyring{
+		active:  active,
+		retired: map[string]retiredKey{},
+	}, nil
+}
+
+// Retire adds a key that only verifies tokens until the given time, it is
+// used for the keys that signed tokens before the active key
+func (kr *Keyring) Retire(k *Key, until time.Time) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	kr.retired[k.ID] = retiredKey{key: k, until: until}
+}
+
+// Rotate replaces the active key, the tokens signed with the previous key are
+// verified for the duration of the window
+func (kr *Keyring) Rotate(next *Key, window time.Duration) error {
+	if next.sign == nil {
+		return ErrNoSigningKey
+	}
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	kr.retired[kr.active.ID] = retiredKey{key: kr.active, until: time.Now().Add(window)}
+	delete(kr.retired, next.ID)
+	kr.active = next
+	return nil
+}
+
+// Sign signs the claims with the active key
+func (kr *Keyring) Sign(cl Claims) (string, error) {
+	kr.mu.RLock()
+	k := kr.active
+	kr.mu.RUnlock()
+	token := jwt.NewWithClaims(k.Method, cl)
+	token.Header["kid"] = k.ID
+	return token.SignedString(k.sign)
+}
+
+// Parse verifies a token and returns its claims
+func (kr *Keyring) Parse(tokenStr string) (*AdminClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenStr, &AdminClaims{}, kr.keyfunc)
+	if err != nil {
+		return nil, err
+	}
+	cl, ok := token.Claims.(*AdminClaims)
+	if !ok {
+		return nil, errors.New("auth: error converting to admin claims")
+	}
+	return cl, nil
+}
+
+// keyfunc returns the key that verifies a token based on its kid header.
+// The algorithm of the token has to be the one of the key so that a public
+// key can never be used as an HMAC secret
+func (kr *Keyring) keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	k := kr.key(kid, time.Now())
+	if k == nil || token.Method.Alg() != k.Method.Alg() {
+		return nil, ErrUnknownKey
+	}
+	return k.verify, nil
+}
+
+// key returns the key with the given ID if it can verify tokens at now
+func (kr *Keyring) key(kid string, now time.Time) *Key {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	if kr.active.ID == kid {
+		return kr.active
+	}
+	if r, ok := kr.retired[kid]; ok && now.Before(r.until) {
+		return r.key
+	}
+	return nil
+}
+
+// JWK is a public key in the JSON Web Key format
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKSet is a set of JSON Web Keys as served by a JWKS endpoint
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys that currently verify tokens so that other
+// services can verify them, HMAC secrets are never part of it
+func (kr *Keyring) JWKS() JWKSet {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	set := JWKSet{Keys: []JWK{}}
+	keys := []*Key{kr.active}
+	now := time.Now()
+	for _, r := range kr.retired {
+		if now.Before(r.until) {
+			keys = append(keys, r.key)
+		}
+	}
+	for _, k := range keys {
+		if jwk, ok := k.jwk(); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	return set
+}
+
+// jwk returns the public key in the JSON Web Key format, it is false for an
+// HMAC secret
+func (k *Key) jwk() (JWK, bool) {
+	b64 := base64.RawURLEncoding.EncodeToString
+	switch pub := k.verify.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA", Use: "sig", Alg: k.Method.Alg(), Kid: k.ID,
+			N: b64(pub.N.Bytes()),
+			E: b64(big.NewInt(int64(pub.E)).Bytes()),
+		}, true
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		return JWK{
+			Kty: "EC", Use: "sig", Alg: k.Method.Alg(), Kid: k.ID,
+			Crv: pub.Curve.Params().Name,
+			X:   b64(pad(pub.X.Bytes(), size)),
+			Y:   b64(pad(pub.Y.Bytes(), size)),
+		}, true
+	}
+	return JWK{}, false
+}
+
+// pad left pads the big endian bytes of a coordinate to the size of the curve
+func pad(bb []byte, size int) []byte {
+	if len(bb) >= size {
+		return bb
+	}
+	return append(make([]byte, size-len(bb)), bb...)
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+func testKeys(t *testing.T) (hs, rs, es *Key) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	materials := [][]byte{
+		[]byte("secret"),
+		pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDER}),
+	}
+	if _, err := ParseKey(materials[0], ""); err != ErrMissingKeyID {
+		t.Fatalf("expected a secret without an ID to be rejected got %v", err)
+	}
+	// only the secret is given an ID, the others use the hash of the public key
+	ids := []string{"hs-1", "", ""}
+	keys := make([]*Key, len(materials))
+	for i, bb := range materials {
+		if keys[i], err = ParseKey(bb, ids[i]); err != nil {
+			t.Fatalf("error: %v", err)
+		}
+	}
+	return keys[0], keys[1], keys[2]
+}
+
+func TestSplitKeySource(t *testing.T) {
+	cases := []struct{ source, want, id string }{
+		{"file:jwt_key_dev", "file:jwt_key_dev", ""},
+		{"env:JWT_KEY#2019-01", "env:JWT_KEY", "2019-01"},
+	}
+	for _, c := range cases {
+		if source, id := SplitKeySource(c.source); source != c.want || id != c.id {
+			t.Fatalf("%s: expected %s and %s got %s and %s", c.source, c.want, c.id, source, id)
+		}
+	}
+}
+
+func TestKeyringSignAndParse(t *testing.T) {
+	hs, rs, es := testKeys(t)
+	for _, k := range []*Key{hs, rs, es} {
+		kr, err := NewKeyring(k)
+		if err != nil {
+			t.Fatalf("error: %v", err)
+		}
+		token, err := kr.Sign(NewAdmin("admin-1", string(RoleOrganizer)))
+		if err != nil {
+			t.Fatalf("%s: error: %v", k.Method.Alg(), err)
+		}
+		cl, err := kr.Parse(token)
+		if err != nil || cl.ID() != "admin-1" {
+			t.Fatalf("%s: expected admin-1 got %v: %v", k.Method.Alg(), cl, err)
+		}
+	}
+}
+
+func TestKeyringRotation(t *testing.T) {
+	hs, rs, es := testKeys(t)
+	kr, _ := NewKeyring(rs)
+	old, _ := kr.Sign(NewAdmin("admin-1", string(RoleOrganizer)))
+	if err := kr.Rotate(es, time.Hour); err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if _, err := kr.Parse(old); err != nil {
+		t.Fatalf("expected the retired key to verify during the window: %v", err)
+	}
+	if jwks := kr.JWKS(); len(jwks.Keys) != 2 {
+		t.Fatalf("expected the active and the retired key got %+v", jwks)
+	}
+	kr.Retire(rs, time.Now().Add(-time.Second))
+	if _, err := kr.Parse(old); err == nil {
+		t.Fatalf("expected the retired key to be rejected after the window")
+	}
+	kr.Retire(hs, time.Now().Add(time.Hour))
+	if jwks := kr.JWKS(); len(jwks.Keys) != 1 || jwks.Keys[0].Kid != es.ID {
+		t.Fatalf("expected only the active public key got %+v", jwks)
+	}
+}
+
+func TestKeyringRejectsAlgorithmConfusion(t *testing.T) {
+	_, rs, _ := testKeys(t)
+	kr, _ := NewKeyring(rs)
+	pub, _ := x509.MarshalPKIXPublicKey(rs.verify)
+	// an HS256 token that uses the public key as the secret
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, NewAdmin("admin-1", string(RoleSuperAdmin)))
+	token.Header["kid"] = rs.ID
+	forged, _ := token.SignedString(pub)
+	if _, err := kr.Parse(forged); err == nil {
+		t.Fatalf("expected a token signed with the public key to be rejected")
+	}
+}
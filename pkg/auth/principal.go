@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+)
+
+// Kind is the kind of user that a token was issued to
+type Kind string
+
+// All the kinds of users that can be issued a token
+const (
+	KindAdmin   Kind = "admin"
+	KindSponsor Kind = "sponsor"
+)
+
+// Principal is the user making a request as described by the claims of
+// their token
+type Principal struct {
+	Kind Kind
+	// SubjectID is the ID of the admin or the sponsor
+	SubjectID string
+	// CompanyID is the ID of the company of a sponsor, it is empty for admins
+	CompanyID string
+	Roles     []Role
+}
+
+// IsAdmin reports whether the principal is an admin
+func (p *Principal) IsAdmin() bool {
+	return p.Kind == KindAdmin
+}
+
+// IsSponsor reports whether the principal is a sponsor
+func (p *Principal) IsSponsor() bool {
+	return p.Kind == KindSponsor
+}
+
+// Can reports whether any of the roles of the principal grants the permission
+func (p *Principal) Can(perm Permission) bool {
+	return grants(p.Roles, perm)
+}
+
+type principalKey struct{}
+
+// WithPrincipal embeds the principal in the context, it is done once the
+// token of a request has been verified
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal making the request. The token in
+// the request is verified when no principal has been embedded in the context
+func PrincipalFromContext(ctx context.Context) (*Principal, error) {
+	if p, ok := ctx.Value(principalKey{}).(*Principal); ok {
+		return p, nil
+	}
+	cl, err := FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cl.Principal(), nil
+}
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnknownRole is returned when an ACL has a role that does not exist or
+// that can not be given to that kind of user
+var ErrUnknownRole = errors.New("auth: unknown role")
+
+// Role is a named set of permissions, the ACL of an admin or a sponsor is a
+// comma separated list of roles
+type Role string
+
+// All the roles of the system. The admin roles can only be given to admins and
+// the sponsor roles can only be given to sponsors, ReadOnly can be given to
+// both
+const (
+	RoleSuperAdmin       Role = "super-admin"
+	RoleOrganizer        Role = "organizer"
+	RoleSponsorManager   Role = "sponsor-manager"
+	RoleSponsorRecruiter Role = "sponsor-recruiter"
+	RoleReadOnly         Role = "read-only"
+)
+
+// Permission is a single action that a role is allowed to perform
+type Permission string
+
+// All the permissions that are checked by the RPC calls
+const (
+	PermAdminsRead       Permission = "admins:read"
+	PermAdminsWrite      Permission = "admins:write"
+	PermSponsorsRead     Permission = "sponsors:read"
+	PermSponsorsWrite    Permission = "sponsors:write"
+	PermCompaniesRead    Permission = "companies:read"
+	PermCompaniesWrite   Permission = "companies:write"
+	PermCompanyManage    Permission = "company:manage"
+	PermParticipantsRead Permission = "participants:read"
+	PermResumesRead      Permission = "resumes:read"
+	PermShortlistRead    Permission = "shortlist:read"
+	PermShortlistWrite   Permission = "shortlist:write"
+	PermNotesRead        Permission = "notes:read"
+	PermNotesWrite       Permission = "notes:write"
+	PermSyncRead         Permission = "sync:read"
+	PermSyncWrite        Permission = "sync:write"
+	PermProfileRead      Permission = "profile:read"
+)
+
+// rolePermissions maps every role to the permissions that it grants
+// NOTE: the sponsor roles must never grant a permission that is only checked
+// by RPC calls meant for admins
+var rolePermissions = map[Role][]Permission{
+	RoleSuperAdmin: {
+		PermAdminsRead, PermAdminsWrite, PermSponsorsRead, PermSponsorsWrite,
+		PermCompaniesRead, PermCompaniesWrite, PermParticipantsRead,
+		PermResumesRead, PermSyncRead, PermSyncWrite, PermProfileRead,
+	},
+	RoleOrganizer: {
+		PermAdminsRead, PermSponsorsRead, PermSponsorsWrite, PermCompaniesRead,
+		PermCompaniesWrite, PermParticipantsRead, PermResumesRead,
+		PermSyncRead, PermSyncWrite, PermProfileRead,
+	},
+	RoleSponsorManager: {
+		PermCompaniesRead, PermCompanyManage, PermParticipantsRead,
+		PermResumesRead, PermShortlistRead, PermShortlistWrite, PermNotesRead,
+		PermNotesWrite, PermProfileRead,
+	},
+	RoleSponsorRecruiter: {
+		PermCompaniesRead, PermParticipantsRead, PermResumesRead,
+		PermShortlistRead, PermShortlistWrite, PermNotesRead, PermNotesWrite,
+		PermProfileRead,
+	},
+	RoleReadOnly: {
+		PermCompaniesRead, PermParticipantsRead, PermShortlistRead,
+		PermNotesRead, PermProfileRead,
+	},
+}
+
+// AdminRoles are the roles that can be given to an admin
+var AdminRoles = []Role{RoleSuperAdmin, RoleOrganizer, RoleReadOnly}
+
+// SponsorRoles are the roles that can be given to a sponsor
+var SponsorRoles = []Role{RoleSponsorManager, RoleSponsorRecruiter, RoleReadOnly}
+
+// Roles splits an ACL into its roles
+func Roles(acl string) []Role {
+	roles := []Role{}
+	for _, r := range strings.Split(acl, ",") {
+		if r = strings.TrimSpace(r); len(r) > 0 {
+			roles = append(roles, Role(r))
+		}
+	}
+	return roles
+}
+
+// ValidateACL returns ErrUnknownRole when the ACL is empty or has a role that
+// is not one of the allowed roles
+func ValidateACL(acl string, allowed []Role) error {
+	roles := Roles(acl)
+	if len(roles) == 0 {
+		return ErrUnknownRole
+	}
+	for _, r := range roles {
+		if !hasRole(allowed, r) {
+			return ErrUnknownRole
+		}
+	}
+	return nil
+}
+
+// hasRole reports whether r is one of roles
+func hasRole(roles []Role, r Role) bool {
+	for _, role := range roles {
+		if role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission reports whether any of the roles in the ACL grants the
+// permission, unknown roles grant nothing
+func HasPermission(acl string, p Permission) bool {
+	return grants(Roles(acl), p)
+}
+
+// grants reports whether any of the roles grants the permission
+func grants(roles []Role, p Permission) bool {
+	for _, r := range roles {
+		for _, perm := range rolePermissions[r] {
+			if perm == p {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrInvalidSignature is returned when a signed URL was not signed with
+	// the key of the server or was modified after it was signed
+	ErrInvalidSignature = errors.New("auth: invalid signature")
+	// ErrSignatureExpired is returned when a signed URL is used after it
+	// has expired
+	ErrSignatureExpired = errors.New("auth: signature expired")
+)
+
+// SignURL returns a URL for path that is valid until expires without any
+// other authentication. The URL is signed for a single user so that every use
+// of the URL can be traced back to the user it was issued to
+func SignURL(key []byte, path, userID string, expires time.Time) string {
+	exp := strconv.FormatInt(expires.Unix(), 10)
+	q := url.Values{}
+	q.Set("user", userID)
+	q.Set("expires", exp)
+	q.Set("signature", signature(key, path, userID, exp))
+	return path + "?" + q.Encode()
+}
+
+// VerifyURL checks the signature of a URL returned by SignURL and returns the
+// ID of the user that it was issued to
+func VerifyURL(key []byte, u *url.URL, now time.Time) (string, error) {
+	q := u.Query()
+	userID, exp := q.Get("user"), q.Get("expires")
+	sig, err := base64.RawURLEncoding.DecodeString(q.Get("signature"))
+	if err != nil {
+		return "", ErrInvalidSignature
+	}
+	expected, _ := base64.RawURLEncoding.DecodeString(signature(key, u.Path, userID, exp))
+	if !hmac.Equal(sig, expected) {
+		return "", ErrInvalidSignature
+	}
+	expires, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil {
+		return "", ErrInvalidSignature
+	}
+	if now.Unix() > expires {
+		return "", ErrSignatureExpired
+	}
+	return userID, nil
+}
+
+// signature is the HMAC-SHA256 of the parts of a signed URL, the parts are
+// separated by a NUL byte which can not appear in any of them
+func signature(key []byte, path, userID, expires string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(path + "\x00" + userID + "\x00" + expires))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSignURL(t *testing.T) {
+	key := []byte("secret")
+	now := time.Unix(1550000000, 0)
+	signed := SignURL(key, "/v1/sponsor/resumes/42", "sponsor-1", now.Add(time.Minute))
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	userID, err := VerifyURL(key, u, now)
+	if err != nil || userID != "sponsor-1" {
+		t.Fatalf("expected sponsor-1 got %s: %v", userID, err)
+	}
+	if _, err := VerifyURL(key, u, now.Add(2*time.Minute)); err != ErrSignatureExpired {
+		t.Fatalf("expected expired signature got: %v", err)
+	}
+	if _, err := VerifyURL([]byte("other"), u, now); err != ErrInvalidSignature {
+		t.Fatalf("expected invalid signature got: %v", err)
+	}
+	u.Path = "/v1/sponsor/resumes/43"
+	if _, err := VerifyURL(key, u, now); err != ErrInvalidSignature {
+		t.Fatalf("expected invalid signature for another path got: %v", err)
+	}
+}
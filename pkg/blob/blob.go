@@ -0,0 +1,47 @@
+// Package blob provides content addressed storage for files such as resumes.
+// Every blob is stored under the hex encoded SHA-256 hash of its content so
+// that a file is only ever stored once and unchanged files can be skipped
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNotFound is returned when there is no blob with the given key
+	ErrNotFound = errors.New("blob: not found")
+	// ErrInvalidKey is returned when a key is not a hex encoded SHA-256 hash
+	ErrInvalidKey = errors.New("blob: invalid key")
+)
+
+var keyRegexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Store is implemented by everything that can store blobs, the filesystem
+// store is the only implementation for now but an object store like S3 or
+// GCS can implement it as well
+type Store interface {
+	// Put stores bb and returns its key
+	Put(ctx context.Context, bb []byte) (string, error)
+	// Get returns the blob stored under key or ErrNotFound
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Has reports whether a blob is stored under key
+	Has(ctx context.Context, key string) (bool, error)
+}
+
+// Key returns the key of a blob with the given content
+func Key(bb []byte) string {
+	sum := sha256.Sum256(bb)
+	return hex.EncodeToString(sum[:])
+}
+
+func validKey(key string) error {
+	if !keyRegexp.MatchString(key) {
+		return ErrInvalidKey
+	}
+	return nil
+}
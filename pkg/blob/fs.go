@@ -0,0 +1,89 @@
+package blob
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// FSStore stores blobs as files in a directory. The files are spread over
+// sub directories named after the first two characters of their key so that
+// no directory ends up with thousands of files
+type FSStore struct {
+	root string
+}
+
+// NewFSStore returns a store that keeps the blobs in the root directory, the
+// directory is created if it does not exist
+func NewFSStore(root string) (*FSStore, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, errors.Wrap(err, "blob: error while creating store directory")
+	}
+	return &FSStore{root: root}, nil
+}
+
+func (s *FSStore) path(key string) string {
+	return filepath.Join(s.root, key[:2], key)
+}
+
+// Put writes bb to a temporary file which is renamed once it is complete so
+// that a partially written blob is never read
+func (s *FSStore) Put(ctx context.Context, bb []byte) (string, error) {
+	key := Key(bb)
+	p := s.path(key)
+	if _, err := os.Stat(p); err == nil {
+		return key, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return "", errors.Wrap(err, "blob: error while creating directory")
+	}
+	f, err := ioutil.TempFile(filepath.Dir(p), key+".tmp")
+	if err != nil {
+		return "", errors.Wrap(err, "blob: error while creating file")
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(bb); err != nil {
+		f.Close()
+		return "", errors.Wrap(err, "blob: error while writing file")
+	}
+	if err := f.Close(); err != nil {
+		return "", errors.Wrap(err, "blob: error while closing file")
+	}
+	if err := os.Rename(f.Name(), p); err != nil {
+		return "", errors.Wrap(err, "blob: error while renaming file")
+	}
+	return key, nil
+}
+
+// Get reads the blob stored under key
+func (s *FSStore) Get(ctx context.Context, key string) ([]byte, error) {
+	if err := validKey(key); err != nil {
+		return nil, err
+	}
+	bb, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "blob: error while reading file")
+	}
+	return bb, nil
+}
+
+// Has reports whether there is a file for key
+func (s *FSStore) Has(ctx context.Context, key string) (bool, error) {
+	if err := validKey(key); err != nil {
+		return false, err
+	}
+	_, err := os.Stat(s.path(key))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "blob: error while reading file")
+	}
+	return true, nil
+}
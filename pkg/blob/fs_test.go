@@ -0,0 +1,41 @@
+package blob
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestFSStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s, err := NewFSStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	key, err := s.Put(ctx, []byte("resume"))
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if key != Key([]byte("resume")) {
+		t.Fatalf("unexpected key: %s", key)
+	}
+	if ok, err := s.Has(ctx, key); err != nil || !ok {
+		t.Fatalf("expected blob to exist: %v", err)
+	}
+	bb, err := s.Get(ctx, key)
+	if err != nil || string(bb) != "resume" {
+		t.Fatalf("unexpected blob %q: %v", bb, err)
+	}
+	if _, err := s.Get(ctx, Key([]byte("missing"))); err != ErrNotFound {
+		t.Fatalf("expected not found got: %v", err)
+	}
+	if _, err := s.Get(ctx, "../../etc/passwd"); err != ErrInvalidKey {
+		t.Fatalf("expected invalid key got: %v", err)
+	}
+}
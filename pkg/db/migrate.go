@@ -38,8 +38,10 @@ func Migrate(quit chan os.Signal) error {
 	if err != nil {
 		return errors.Wrap(err, "could not migrate")
 	}
+	if err := migrator.Up(); err != nil && err != migrate.ErrNoChange {
+		return errors.Wrap(err, "could not run migrations")
+	}
 	log.Debug("finished performing migrations")
-	migrator.Steps(2)
 	return nil
 }
 
@@ -0,0 +1,126 @@
+// Package mail delivers the emails sent by the server such as invites and
+// password resets
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Message is a plain text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers emails
+type Mailer interface {
+	Send(ctx context.Context, m Message) error
+}
+
+// DefaultMailer is the mailer used for every email sent by the server, it
+// can be replaced before the server is started
+var DefaultMailer Mailer = LogMailer{}
+
+// Send sends the message with the DefaultMailer
+func Send(ctx context.Context, m Message) error {
+	return DefaultMailer.Send(ctx, m)
+}
+
+// LogMailer logs every message instead of delivering it, it is meant for
+// local development
+type LogMailer struct{}
+
+// Send logs the message
+func (LogMailer) Send(ctx context.Context, m Message) error {
+	log.WithFields(log.Fields{
+		"to":      m.To,
+		"subject": m.Subject,
+	}).Info(m.Body)
+	return nil
+}
+
+// FileMailer appends every message to a file instead of delivering it, it is
+// meant for local testing
+type FileMailer struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileMailer returns a mailer that appends the messages to the file
+func NewFileMailer(path string) *FileMailer {
+	return &FileMailer{path: path}
+}
+
+// Send appends the message to the file
+func (f *FileMailer) Send(ctx context.Context, m Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "pkg/mail: error while opening mail file")
+	}
+	defer file.Close()
+	if _, err := file.Write(format("", m)); err != nil {
+		return errors.Wrap(err, "pkg/mail: error while writing mail")
+	}
+	return nil
+}
+
+// SMTPMailer delivers messages through an SMTP server
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer returns a mailer that sends messages from the given address
+// through the SMTP server at addr. PLAIN authentication is used when a
+// username is given
+func NewSMTPMailer(addr, username, password, from string) (*SMTPMailer, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "pkg/mail: invalid smtp address")
+	}
+	m := &SMTPMailer{addr: addr, from: from}
+	if len(username) > 0 {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m, nil
+}
+
+// Send delivers the message
+func (s *SMTPMailer) Send(ctx context.Context, m Message) error {
+	err := smtp.SendMail(s.addr, s.auth, s.from, []string{m.To}, format(s.from, m))
+	if err != nil {
+		return errors.Wrap(err, "pkg/mail: error while sending mail")
+	}
+	return nil
+}
+
+// format formats the message with its headers, line breaks are removed from
+// the headers so that they can not be used to inject other headers
+func format(from string, m Message) []byte {
+	header := strings.NewReplacer("\r", "", "\n", "")
+	var b strings.Builder
+	if len(from) > 0 {
+		fmt.Fprintf(&b, "From: %s\r\n", header.Replace(from))
+	}
+	fmt.Fprintf(&b, "To: %s\r\n", header.Replace(m.To))
+	fmt.Fprintf(&b, "Subject: %s\r\n", header.Replace(m.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(m.Body)
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
@@ -0,0 +1,36 @@
+package mail
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileMailer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.txt")
+	m := NewFileMailer(path)
+	msgs := []Message{
+		{To: "a@example.com", Subject: "Invite", Body: "first"},
+		{To: "b@example.com\r\nBcc: evil@example.com", Subject: "Reset", Body: "second"},
+	}
+	for _, msg := range msgs {
+		if err := m.Send(context.Background(), msg); err != nil {
+			t.Fatalf("error: %v", err)
+		}
+	}
+	bb, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	out := string(bb)
+	for _, s := range []string{"To: a@example.com\r\n", "Subject: Invite\r\n", "first", "second"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("expected %q in %q", s, out)
+		}
+	}
+	if strings.Contains(out, "\r\nBcc:") {
+		t.Fatalf("header injected: %q", out)
+	}
+}
@@ -0,0 +1,102 @@
+// Package pagination provides the opaque page tokens that are used by all
+// the list RPC calls. Every paginated list is ordered by the creation time
+// of a row and its ID so that the order is stable between calls
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultLimit is the page size used when a client does not ask for one
+	DefaultLimit = 100
+	// MaxLimit is the largest page size a client is allowed to ask for
+	MaxLimit = 1000
+)
+
+// ErrInvalidToken is an error that is returned when a page token could not
+// be decoded
+var ErrInvalidToken = errors.New("pagination: invalid page token")
+
+// Cursor points to the last row of a page
+type Cursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+// Token encodes the cursor into an opaque string that can be sent to a client
+func (c Cursor) Token() string {
+	bb, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(bb)
+}
+
+// Page describes the page of rows that has been requested by a client
+type Page struct {
+	// Limit is the maximum number of rows in the page, a zero value means
+	// that all the rows have to be returned
+	Limit  int
+	cursor *Cursor
+}
+
+// New returns a Page from the token and limit sent by a client. The limit
+// is bounded by MaxLimit and DefaultLimit is used if no limit was provided
+func New(token string, limit int32) (Page, error) {
+	p := Page{Limit: int(limit)}
+	if p.Limit <= 0 {
+		p.Limit = DefaultLimit
+	}
+	if p.Limit > MaxLimit {
+		p.Limit = MaxLimit
+	}
+	token = strings.TrimSpace(token)
+	if len(token) == 0 {
+		return p, nil
+	}
+	bb, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return p, ErrInvalidToken
+	}
+	c := new(Cursor)
+	if err := json.Unmarshal(bb, c); err != nil || len(c.ID) == 0 {
+		return p, ErrInvalidToken
+	}
+	p.cursor = c
+	return p, nil
+}
+
+// Condition returns a SQL condition that only matches rows after the cursor of
+// the page and adds the named arguments that it needs to args. An empty string
+// is returned for the first page
+func (p Page) Condition(args map[string]interface{}) string {
+	if p.cursor == nil {
+		return ""
+	}
+	args["cursor_created_at"] = p.cursor.CreatedAt
+	args["cursor_id"] = p.cursor.ID
+	return "(created_at, id) > (:cursor_created_at, :cursor_id)"
+}
+
+// Clause returns the ORDER BY and LIMIT clauses of the SQL query for the page.
+// One extra row is fetched to find out whether there is a next page
+func (p Page) Clause(args map[string]interface{}) string {
+	if p.Limit <= 0 {
+		return "ORDER BY created_at, id"
+	}
+	args["page_limit"] = p.Limit + 1
+	return "ORDER BY created_at, id LIMIT :page_limit"
+}
+
+// Next takes the number of rows returned by the query built with Clause and
+// returns how many of them belong to the page. cursorAt is only called when
+// there is a next page and has to return the cursor of the row at index i
+func (p Page) Next(n int, cursorAt func(i int) Cursor) (int, string) {
+	if p.Limit <= 0 || n <= p.Limit {
+		return n, ""
+	}
+	return p.Limit, cursorAt(p.Limit - 1).Token()
+}
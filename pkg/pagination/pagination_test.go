@@ -0,0 +1,55 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenRoundTrip(t *testing.T) {
+	c := Cursor{
+		CreatedAt: time.Date(2019, 2, 9, 10, 30, 0, 123000, time.UTC),
+		ID:        "54708071-4f77-4f62-9bed-6a903e4248c9",
+	}
+	p, err := New(c.Token(), 10)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	args := map[string]interface{}{}
+	if cond := p.Condition(args); len(cond) == 0 {
+		t.Fatal("expected a condition for a page with a token")
+	}
+	if !args["cursor_created_at"].(time.Time).Equal(c.CreatedAt) || args["cursor_id"] != c.ID {
+		t.Fatalf("unexpected arguments: %v", args)
+	}
+}
+
+func TestInvalidToken(t *testing.T) {
+	if _, err := New("not-a-token", 10); err != ErrInvalidToken {
+		t.Fatalf("expected ErrInvalidToken got: %v", err)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	p, _ := New("", 0)
+	if p.Limit != DefaultLimit {
+		t.Fatalf("expected default limit got: %d", p.Limit)
+	}
+	p, _ = New("", MaxLimit+1)
+	if p.Limit != MaxLimit {
+		t.Fatalf("expected max limit got: %d", p.Limit)
+	}
+}
+
+func TestNext(t *testing.T) {
+	p, _ := New("", 2)
+	cursorAt := func(i int) Cursor {
+		return Cursor{ID: string(rune('a' + i))}
+	}
+	if n, next := p.Next(2, cursorAt); n != 2 || next != "" {
+		t.Fatalf("expected last page got: %d %s", n, next)
+	}
+	n, next := p.Next(3, cursorAt)
+	if n != 2 || next != (Cursor{ID: "b"}).Token() {
+		t.Fatalf("expected a next page after the second row got: %d %s", n, next)
+	}
+}
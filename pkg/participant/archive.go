@@ -0,0 +1,197 @@
+package participant
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"context"
+	"io"
+	"time"
+
+	"github.com/auburnhacks/sponsor/pkg/pagination"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// FormatTar is the format of a tar archive of resumes
+	FormatTar = "tar"
+	// FormatZip is the format of a zip archive of resumes
+	FormatZip = "zip"
+)
+
+// ErrUnsupportedFormat is an error returned when an archive of resumes is
+// requested in a format other than tar or zip
+var ErrUnsupportedFormat = errors.New("participant: archive format should be tar or zip")
+
+// ContentType returns the MIME type of an archive format
+func ContentType(format string) string {
+	if format == FormatZip {
+		return "application/zip"
+	}
+	return "application/x-tar"
+}
+
+// archiveWriter writes files to an archive as they are added to it
+type archiveWriter interface {
+	add(name string, bb []byte) error
+	Close() error
+}
+
+func newArchiveWriter(format string, w io.Writer) (archiveWriter, error) {
+	switch format {
+	case "", FormatTar:
+		return &tarWriter{tar.NewWriter(w)}, nil
+	case FormatZip:
+		return &zipWriter{zip.NewWriter(w)}, nil
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+type tarWriter struct {
+	tw *tar.Writer
+}
+
+func (t *tarWriter) add(name string, bb []byte) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(bb)),
+		ModTime: time.Now(),
+	}
+	if err := t.tw.WriteHeader(hdr); err != nil {
+		return errors.Wrap(err,
+			"pkg/participant: error while writing header.")
+	}
+	if _, err := t.tw.Write(bb); err != nil {
+		return errors.Wrap(err,
+			"pkg/participant: error while wrint to tar archive")
+	}
+	return nil
+}
+
+func (t *tarWriter) Close() error {
+	if err := t.tw.Close(); err != nil {
+		return errors.Wrap(err,
+			"pkg/participant: error while closing tar archive")
+	}
+	return nil
+}
+
+type zipWriter struct {
+	zw *zip.Writer
+}
+
+func (z *zipWriter) add(name string, bb []byte) error {
+	// PDFs are already compressed, storing them saves a lot of cpu time
+	fw, err := z.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Store,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return errors.Wrap(err,
+			"pkg/participant: error while writing zip header")
+	}
+	if _, err := fw.Write(bb); err != nil {
+		return errors.Wrap(err,
+			"pkg/participant: error while writing to zip archive")
+	}
+	return nil
+}
+
+func (z *zipWriter) Close() error {
+	if err := z.zw.Close(); err != nil {
+		return errors.Wrap(err,
+			"pkg/participant: error while closing zip archive")
+	}
+	return nil
+}
+
+// WriteResumes downloads the resumes of the participants that match the filter
+// and writes them to w as an archive of the given format. Every resume is
+// written to w as soon as it has been downloaded so the archive never has to be
+// held in memory.
+// The files are named after the participants and a manifest.csv and a
+// manifest.json that map every file to the profile of its participant are
+// added at the end of the archive. Resumes are read from ResumeStore when
+// they have been mirrored. The resumes that could not be downloaded
+// are returned and listed in a failed.csv in the archive
+func WriteResumes(ctx context.Context, w io.Writer, format string, f Filter) ([]Failure, error) {
+	aw, err := newArchiveWriter(format, w)
+	if err != nil {
+		return nil, err
+	}
+	f.HasResume = true
+	participants, _, err := List(f, pagination.Page{})
+	if err != nil {
+		return nil, err
+	}
+	manifest := []ManifestEntry{}
+	add := func(p Participant, url, contentType string, bb []byte) error {
+		name := resumeFileName(p, url, contentType)
+		if err := aw.add(name, bb); err != nil {
+			return err
+		}
+		manifest = append(manifest, newManifestEntry(p, name, contentType))
+		return nil
+	}
+	// the mirrored resumes are written first and only the remaining ones
+	// are downloaded
+	missing := []Participant{}
+	for _, p := range participants {
+		bb, ok := cachedResume(ctx, p)
+		if !ok {
+			missing = append(missing, p)
+			continue
+		}
+		if err := add(p, p.Resume, p.ResumeContentType, bb); err != nil {
+			return nil, err
+		}
+	}
+	urls := make([]string, len(missing))
+	for i, p := range missing {
+		urls[i] = p.Resume
+	}
+	// the downloads are cancelled when the archive can not be written
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	failed := []Failure{}
+	for res := range DefaultDownloader.Stream(ctx, urls) {
+		p := missing[res.Index]
+		if res.Err != nil {
+			log.Warnf("error while downloading resume of %s: %v", p.ID, res.Err)
+			failed = append(failed, newFailure(p, res.Err))
+			continue
+		}
+		if err := add(p, res.URL, res.ContentType, res.Data); err != nil {
+			return nil, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	mCSV, err := manifestCSV(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if err := aw.add("manifest.csv", mCSV); err != nil {
+		return nil, err
+	}
+	mJSON, err := manifestJSON(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if err := aw.add("manifest.json", mJSON); err != nil {
+		return nil, err
+	}
+	if len(failed) > 0 {
+		fCSV, err := failuresCSV(failed)
+		if err != nil {
+			return nil, err
+		}
+		if err := aw.add("failed.csv", fCSV); err != nil {
+			return nil, err
+		}
+	}
+	return failed, aw.Close()
+}
@@ -0,0 +1,95 @@
+package participant
+
+import (
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// Filter is a struct that describes the conditions used to narrow down
+// the participants returned by List. A zero value for any of the fields
+// means that the field is not used for filtering
+type Filter struct {
+	// IDs narrows down the participants to the participants with the given
+	// IDs, the other fields are applied on top of it
+	IDs         []string
+	University  string
+	Major       string
+	GradYearMin int
+	GradYearMax int
+	HasResume   bool
+	HasGithub   bool
+	// Query is a free text search that is matched against the name and
+	// the email of a participant
+	Query string
+	// ShortlistedBy is the ID of a company, only the participants on the
+	// shortlist of that company are returned when it is set
+	ShortlistedBy string
+}
+
+// conditions builds the SQL conditions for the filter along with the named
+// arguments that have to be bound to the query
+// NOTE: the values are never interpolated into the query string
+func (f Filter) conditions() ([]string, map[string]interface{}) {
+	conds := []string{}
+	args := map[string]interface{}{}
+	if len(f.IDs) > 0 {
+		// the IDs are compared as text so that a malformed ID does not match
+		// instead of failing the whole query
+		conds = append(conds, "id::text = ANY(:ids)")
+		args["ids"] = pq.Array(f.IDs)
+	}
+	if len(f.University) > 0 {
+		conds = append(conds, "university ILIKE :university")
+		args["university"] = escapeLike(f.University)
+	}
+	if len(f.Major) > 0 {
+		conds = append(conds, "major ILIKE :major")
+		args["major"] = escapeLike(f.Major)
+	}
+	if f.GradYearMin > 0 {
+		conds = append(conds, "grad_year >= :grad_year_min")
+		args["grad_year_min"] = f.GradYearMin
+	}
+	if f.GradYearMax > 0 {
+		conds = append(conds, "grad_year <= :grad_year_max")
+		args["grad_year_max"] = f.GradYearMax
+	}
+	if f.HasResume {
+		conds = append(conds, "resume_url <> ''")
+	}
+	if f.HasGithub {
+		conds = append(conds, "github <> ''")
+	}
+	if q := strings.TrimSpace(f.Query); len(q) > 0 {
+		conds = append(conds, "(name ILIKE :query OR email ILIKE :query)")
+		args["query"] = "%" + escapeLike(q) + "%"
+	}
+	if len(f.ShortlistedBy) > 0 {
+		conds = append(conds, `id IN (
+		SELECT participant_id FROM shortlists WHERE company_id = :shortlisted_by)`)
+		args["shortlisted_by"] = f.ShortlistedBy
+	}
+	return conds, args
+}
+
+// where joins all the non empty conditions into a WHERE clause
+func where(conds ...string) string {
+	nonEmpty := []string{}
+	for _, c := range conds {
+		if len(c) > 0 {
+			nonEmpty = append(nonEmpty, c)
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(nonEmpty, " AND ")
+}
+
+// escapeLike escapes all the characters that have a special meaning in
+// a LIKE pattern so that user input is always matched literally
+func escapeLike(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return r.Replace(s)
+}
@@ -0,0 +1,53 @@
+package participant
+
+import (
+	"testing"
+)
+
+func TestEmptyFilter(t *testing.T) {
+	conds, args := Filter{}.conditions()
+	if w := where(conds...); w != "" {
+		t.Fatalf("expected empty where clause got: %s", w)
+	}
+	if len(args) != 0 {
+		t.Fatalf("expected no arguments got: %v", args)
+	}
+}
+
+func TestFilterWhere(t *testing.T) {
+	f := Filter{
+		Major:       "Computer Science",
+		GradYearMin: 2020,
+		GradYearMax: 2021,
+		HasResume:   true,
+	}
+	conds, args := f.conditions()
+	expected := "WHERE major ILIKE :major AND grad_year >= :grad_year_min AND " +
+		"grad_year <= :grad_year_max AND resume_url <> ''"
+	if w := where(conds...); w != expected {
+		t.Fatalf("expected: %s got: %s", expected, w)
+	}
+	if args["major"] != "Computer Science" || args["grad_year_min"] != 2020 ||
+		args["grad_year_max"] != 2021 {
+		t.Fatalf("unexpected arguments: %v", args)
+	}
+}
+
+func TestFilterQueryEscaped(t *testing.T) {
+	_, args := Filter{Query: " 100%_sure "}.conditions()
+	if args["query"] != `%100\%\_sure%` {
+		t.Fatalf("query was not escaped: %v", args["query"])
+	}
+}
+
+func TestFilterIDs(t *testing.T) {
+	f := Filter{IDs: []string{"a", "b"}, Major: "Math"}
+	conds, args := f.conditions()
+	expected := "WHERE id::text = ANY(:ids) AND major ILIKE :major"
+	if w := where(conds...); w != expected {
+		t.Fatalf("expected: %s got: %s", expected, w)
+	}
+	if _, ok := args["ids"]; !ok {
+		t.Fatalf("expected ids argument got: %v", args)
+	}
+}
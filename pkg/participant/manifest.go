@@ -0,0 +1,175 @@
+package participant
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"mime"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// resumeExts are the extensions of resumes that are kept from the url of a
+// resume when its content type does not map to an extension
+var resumeExts = map[string]bool{
+	".pdf":  true,
+	".doc":  true,
+	".docx": true,
+	".odt":  true,
+	".rtf":  true,
+	".txt":  true,
+}
+
+// preferredExts are the extensions used for common content types since
+// mime.ExtensionsByType can return several extensions in any order
+var preferredExts = map[string]string{
+	"application/pdf":    ".pdf",
+	"application/msword": ".doc",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": ".docx",
+	"text/plain": ".txt",
+}
+
+// ManifestEntry maps a file in an archive of resumes to the profile of the
+// participant the resume belongs to
+type ManifestEntry struct {
+	File        string `json:"file"`
+	ContentType string `json:"content_type"`
+	ID          string `json:"id"`
+	ExternalID  string `json:"external_id"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	University  string `json:"university"`
+	Major       string `json:"major"`
+	GradYear    int    `json:"grad_year"`
+	Github      string `json:"github"`
+	Linkedin    string `json:"linkedin"`
+}
+
+func newManifestEntry(p Participant, file, contentType string) ManifestEntry {
+	return ManifestEntry{
+		File:        file,
+		ContentType: contentType,
+		ID:          p.ID,
+		ExternalID:  p.ExternalID,
+		Name:        p.Name,
+		Email:       p.Email,
+		University:  p.University,
+		Major:       p.Major,
+		GradYear:    p.GradYear,
+		Github:      p.Github,
+		Linkedin:    p.Linkedin,
+	}
+}
+
+// Failure describes a participant whose resume could not be downloaded
+type Failure struct {
+	ParticipantID string
+	Name          string
+	ResumeURL     string
+	Err           error
+}
+
+func newFailure(p Participant, err error) Failure {
+	return Failure{
+		ParticipantID: p.ID,
+		Name:          p.Name,
+		ResumeURL:     p.Resume,
+		Err:           err,
+	}
+}
+
+// resumeFileName returns the name of the file of a resume in an archive, it is
+// made of the name, university and ID of the participant so that it is unique
+func resumeFileName(p Participant, resumeURL, contentType string) string {
+	parts := []string{}
+	for _, s := range []string{p.Name, p.University, p.ID} {
+		if s = sanitize(s); len(s) > 0 {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, "-") + resumeExt(resumeURL, contentType)
+}
+
+// ResumeFileName returns the name of the file of the resume of a participant
+// with the given content type
+func (p Participant) ResumeFileName(contentType string) string {
+	return resumeFileName(p, p.Resume, contentType)
+}
+
+// resumeExt returns the extension of a resume based on its content type or
+// the extension in its url, resumes are assumed to be PDFs otherwise
+func resumeExt(resumeURL, contentType string) string {
+	if ext, ok := preferredExts[contentType]; ok {
+		return ext
+	}
+	if u, err := url.Parse(resumeURL); err == nil {
+		if ext := strings.ToLower(path.Ext(u.Path)); resumeExts[ext] {
+			return ext
+		}
+	}
+	if contentType == "application/octet-stream" {
+		return ".pdf"
+	}
+	if exts, err := mime.ExtensionsByType(contentType); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+	return ".pdf"
+}
+
+// sanitize replaces whitespace with underscores and drops every character
+// that is not safe to use in a file name
+func sanitize(s string) string {
+	var b strings.Builder
+	for _, r := range strings.Join(strings.Fields(s), "_") {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '_', r == '.':
+			b.WriteRune(r)
+		case r == '-':
+			b.WriteRune('_')
+		}
+	}
+	return strings.Trim(b.String(), "._")
+}
+
+// manifestCSV encodes the manifest of an archive as CSV with a header row
+func manifestCSV(entries []ManifestEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"file", "content_type", "id", "external_id", "name", "email",
+		"university", "major", "grad_year", "github", "linkedin"})
+	for _, e := range entries {
+		w.Write([]string{e.File, e.ContentType, e.ID, e.ExternalID, e.Name, e.Email,
+			e.University, e.Major, strconv.Itoa(e.GradYear), e.Github, e.Linkedin})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, errors.Wrap(err, "pkg/participant: error while writing manifest")
+	}
+	return buf.Bytes(), nil
+}
+
+// manifestJSON encodes the manifest of an archive as a JSON array
+func manifestJSON(entries []ManifestEntry) ([]byte, error) {
+	return json.MarshalIndent(entries, "", "  ")
+}
+
+// failuresCSV encodes the resumes that could not be downloaded as CSV with a
+// header row
+func failuresCSV(failed []Failure) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"id", "name", "error"})
+	for _, f := range failed {
+		w.Write([]string{f.ParticipantID, f.Name, f.Err.Error()})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, errors.Wrap(err, "pkg/participant: error while writing failures")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,29 @@
+package participant
+
+import "testing"
+
+func TestResumeFileName(t *testing.T) {
+	p := Participant{ID: "42", Name: "Jane  Doe", University: "Auburn University/Main"}
+	tests := []struct {
+		url         string
+		contentType string
+		want        string
+	}{
+		{"https://example.com/resume.pdf", "application/pdf", "Jane_Doe-Auburn_UniversityMain-42.pdf"},
+		{"https://example.com/resume.docx?x=1", "application/octet-stream", "Jane_Doe-Auburn_UniversityMain-42.docx"},
+		{"https://example.com/resume", "application/msword", "Jane_Doe-Auburn_UniversityMain-42.doc"},
+		{"https://example.com/resume", "", "Jane_Doe-Auburn_UniversityMain-42.pdf"},
+	}
+	for _, tt := range tests {
+		if got := resumeFileName(p, tt.url, tt.contentType); got != tt.want {
+			t.Errorf("resumeFileName(%q, %q) = %q, want %q", tt.url, tt.contentType, got, tt.want)
+		}
+	}
+}
+
+func TestResumeFileNameEmptyFields(t *testing.T) {
+	p := Participant{ID: "7", Name: "../..", University: ""}
+	if got, want := resumeFileName(p, "", "application/pdf"), "7.pdf"; got != want {
+		t.Errorf("resumeFileName() = %q, want %q", got, want)
+	}
+}
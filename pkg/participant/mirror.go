@@ -0,0 +1,86 @@
+package participant
+
+import (
+	"context"
+
+	"github.com/auburnhacks/sponsor/pkg/blob"
+	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// ResumeStore is the store that the resumes are mirrored to after every sync.
+// Archives of resumes are served from it so that the resumes do not have to
+// be downloaded again, resumes are not mirrored when it is nil
+var ResumeStore blob.Store
+
+// MirrorResumes downloads the resumes that have not been mirrored yet or whose
+// url changed since they were mirrored, extracts their text for searching and
+// stores them in ResumeStore when it is set. It returns the number of resumes
+// that were mirrored along with the resumes that could not be downloaded
+func MirrorResumes(ctx context.Context) (int, []Failure, error) {
+	rows, err := db.Conn.Queryx(`SELECT ` + selectColumns + ` FROM participants
+	WHERE resume_url <> '' AND resume_url <> resume_cached_url`)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "participant: error while listing resumes to mirror")
+	}
+	participants, err := scanParticipants(rows)
+	rows.Close()
+	if err != nil {
+		return 0, nil, err
+	}
+	urls := make([]string, len(participants))
+	for i, p := range participants {
+		urls[i] = p.Resume
+	}
+	mirrored := 0
+	failed := []Failure{}
+	for res := range DefaultDownloader.Stream(ctx, urls) {
+		p := participants[res.Index]
+		if res.Err != nil {
+			log.Warnf("error while mirroring resume of %s: %v", p.ID, res.Err)
+			failed = append(failed, newFailure(p, res.Err))
+			continue
+		}
+		key := blob.Key(res.Data)
+		if ResumeStore != nil {
+			if key, err = ResumeStore.Put(ctx, res.Data); err != nil {
+				return mirrored, failed, err
+			}
+		}
+		// a resume is still mirrored when its text can not be extracted, it
+		// is only not searchable
+		text, err := extractText(res.Data, res.ContentType)
+		if err != nil {
+			log.Warnf("error while extracting text from resume of %s: %v", p.ID, err)
+		}
+		// the url is matched so that a resume that changed while it was being
+		// downloaded is mirrored again on the next sync
+		_, err = db.Conn.Exec(`
+		UPDATE participants SET resume_hash = $1, resume_size = $2,
+		resume_content_type = $3, resume_cached_url = $4, resume_text = $5
+		WHERE id = $6 AND resume_url = $4`,
+			key, len(res.Data), res.ContentType, res.URL, text, p.ID)
+		if err != nil {
+			return mirrored, failed, errors.Wrap(err, "participant: error while saving mirrored resume")
+		}
+		mirrored++
+	}
+	return mirrored, failed, ctx.Err()
+}
+
+// cachedResume returns the mirrored copy of the resume of a participant, ok is
+// false when there is no up to date copy
+func cachedResume(ctx context.Context, p Participant) (bb []byte, ok bool) {
+	if ResumeStore == nil || len(p.ResumeHash) == 0 || p.ResumeCachedURL != p.Resume {
+		return nil, false
+	}
+	bb, err := ResumeStore.Get(ctx, p.ResumeHash)
+	if err != nil {
+		if err != blob.ErrNotFound {
+			log.Warnf("error while reading mirrored resume of %s: %v", p.ID, err)
+		}
+		return nil, false
+	}
+	return bb, true
+}
@@ -4,82 +4,72 @@ package participant
 
 import (
 	"context"
-	"net/url"
-	"strconv"
 	"time"
 
 	"github.com/auburnhacks/sponsor/pkg/db"
-	"github.com/mongodb/mongo-go-driver/bson"
-	"github.com/mongodb/mongo-go-driver/bson/primitive"
-	"github.com/mongodb/mongo-go-driver/mongo"
-	"github.com/pkg/errors"
+	"github.com/auburnhacks/sponsor/pkg/pagination"
+	"github.com/jmoiron/sqlx"
 	log "github.com/sirupsen/logrus"
 )
 
-var (
-	// ErrUnsupportedScheme is a error return by a function when the url contains a
-	// protocol scheme that is unsupported by the system right now
-	ErrUnsupportedScheme = errors.New("participant: this database scheme not supported")
-)
-
 // Participant also implements the participant interface
 type Participant struct {
-	ID         string    `db:"id"`
-	Name       string    `db:"name"`
-	Email      string    `db:"email"`
-	University string    `db:"university"`
-	Major      string    `db:"major"`
-	GradYear   int       `db:"grad_year"`
-	Github     string    `db:"github"`
-	Linkedin   string    `db:"linkedin"`
-	Resume     string    `db:"resume"`
-	CreatedAt  time.Time `db:"created_at"`
-	UpdatedAt  time.Time `db:"updated_at"`
+	ID string `db:"id"`
+	// ExternalID is the ID of the participant in the external database that
+	// the participant was synced from. It never changes between syncs, it is
+	// empty until the first sync for participants synced before it existed
+	ExternalID string `db:"external_id"`
+	Name       string `db:"name"`
+	Email      string `db:"email"`
+	University string `db:"university"`
+	Major      string `db:"major"`
+	GradYear   int    `db:"grad_year"`
+	Github     string `db:"github"`
+	Linkedin   string `db:"linkedin"`
+	Resume     string `db:"resume"`
+	// ResumeHash is the key of the copy of the resume in ResumeStore, it is
+	// empty when the resume has not been mirrored yet
+	ResumeHash        string `db:"resume_hash"`
+	ResumeSize        int64  `db:"resume_size"`
+	ResumeContentType string `db:"resume_content_type"`
+	// ResumeCachedURL is the url the mirrored copy was downloaded from, the
+	// copy is stale when it differs from Resume
+	ResumeCachedURL string    `db:"resume_cached_url"`
+	CreatedAt       time.Time `db:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at"`
 }
 
-type hacker struct {
-	ID      primitive.ObjectID `json:"id" bson:"_id"`
-	Email   string             `json:"email" bson:"email"`
-	Profile struct {
-		Name       string `json:"name" bson:"name"`
-		University string `json:"school" bson:"school"`
-		GradYear   string `json:"graduationYear" bson:"graduationYear"`
-	} `json:"profile" bson:"profile"`
-	Confirmation struct {
-		Github   string `json:"github" bson:"github"`
-		Linkedin string `json:"twitter" bson:"twitter"`
-		Major    string `json:"major" bson:"major"`
-	} `json:"confirmation" bson:"confirmation"`
-	Resume string `json:"url"`
-}
+// selectColumns are the columns scanned by scanParticipants
+const selectColumns = `id, COALESCE(external_id, '') AS external_id, name, email, university, major, grad_year, github,
+	linkedin, resume_url, resume_hash, resume_size, resume_content_type, resume_cached_url,
+	created_at, updated_at`
 
 // Sync should run as a goroutine it will run in the
-// background thread and will uniformly sync all participants to the
-// database for future use.
-func Sync(quillURI, resumesURI string, d time.Duration, quit <-chan struct{}) error {
-	log.Debugf("will sync from external db: %s, %s", quillURI, resumesURI)
-	if err := validScheme(quillURI); err != nil {
-		return err
-	}
-	if err := validScheme(resumesURI); err != nil {
-		return err
-	}
+// background thread and will uniformly sync all participants from the
+// source to the database for future use.
+// Every sync only fetches the participants that were updated since the previous
+// sync, all the participants are fetched at least once every fullEvery to catch
+// the participants that were deleted from the source.
+// A sync can also be started immediately with Trigger
+func Sync(src Source, d, fullEvery time.Duration, quit <-chan struct{}) error {
+	log.Debugf("will sync from source: %s", src.Name())
+	statusMu.Lock()
+	trigger = make(chan bool, 1)
+	t := trigger
+	statusMu.Unlock()
 	go func() {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+		forceFull := false
 		for {
-			pSlice, err := fetchParticipants(quillURI, resumesURI)
-			if err != nil {
-				log.Errorf("error while fetching participants: %v", err)
-			}
-			pSlice, err = fetchResumes(pSlice, resumesURI)
-			if err != nil {
-				log.Errorf("error while fetching resumes: %v", err)
-			}
-			if err := saveToDB(pSlice); err != nil {
-				log.Errorf("error while saving participants to the database: %v", err)
+			if err := syncOnce(src, fullEvery, forceFull); err != nil {
+				log.Errorf("error while syncing participants: %v", err)
 			}
 			select {
-			case <-time.Tick(d):
-				continue
+			case <-ticker.C:
+				forceFull = false
+			case forceFull = <-t:
+				log.Infof("sync triggered, full: %v", forceFull)
 			case <-quit:
 				return
 			}
@@ -88,144 +78,91 @@ func Sync(quillURI, resumesURI string, d time.Duration, quit <-chan struct{}) er
 	return nil
 }
 
-func fetchParticipants(quillURI, resumesURI string) ([]*hacker, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	log.Debug("creating mongo client")
-	client, err := mongo.NewClient(quillURI)
-	if err != nil {
-		return nil, errors.Wrap(err, "participant: error while creating mongo client")
-	}
-	log.Debug("connecting to mongodb")
-	if err := client.Connect(ctx); err != nil {
-		return nil, errors.Wrap(err, "participant: error while connecting to mongodb")
-	}
-	// instance of the users collection on the mongodb for quill
-	// TODO: make this more abstract
-	users := client.Database("quill").Collection("users")
-	cur, err := users.Find(ctx, bson.D{})
-	if err != nil {
-		return nil, errors.Wrap(err, "participant: error while find participants from collection")
-	}
-	defer cur.Close(ctx)
-
-	pSlice := []*hacker{}
-	for cur.Next(ctx) {
-		br, err := cur.DecodeBytes()
-		if err != nil {
-			return nil, errors.Wrap(err, "participant: error while decoding bytes from mongo")
+// syncOnce fetches the participants from the source and saves them. Nothing
+// is saved if the participants could not be fetched since the participants
+// that are missing from the source are deleted during a full sync. The
+// resumes are mirrored before the sync is finished so that mirror failures
+// show up in the status of the sync
+func syncOnce(src Source, fullEvery time.Duration, forceFull bool) error {
+	now := time.Now()
+	startStatus(src.Name(), now)
+	res, err := syncFrom(src, fullEvery, forceFull, now)
+	if err == nil {
+		for _, rErr := range res.Failed {
+			log.Warnf("participant could not be synced: %v", rErr)
 		}
-		var h *hacker
-		if err := bson.Unmarshal(br, &h); err != nil {
-			return nil, errors.Wrap(err, "participant: error while unmarshaling bson to struct")
+		log.Infof("synced %d participants, deleted %d, failed %d",
+			res.Saved, res.Deleted, len(res.Failed))
+		// a resume that could not be mirrored is mirrored on the next sync
+		res.Mirrored, res.MirrorFailed, err = MirrorResumes(context.Background())
+		if err == nil {
+			log.Infof("mirrored %d resumes, failed %d", res.Mirrored, len(res.MirrorFailed))
 		}
-		pSlice = append(pSlice, h)
 	}
-	return pSlice, nil
+	finishStatus(res, err, time.Now())
+	return err
 }
 
-func fetchResumes(pSlice []*hacker, resumesDBURI string) ([]*hacker, error) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	client, err := mongo.NewClient(resumesDBURI)
+func syncFrom(src Source, fullEvery time.Duration, forceFull bool, now time.Time) (*Result, error) {
+	st, err := loadSyncState(src.Name())
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("connecting to resumes mongodb")
-	if err := client.Connect(ctx); err != nil {
-		return nil, err
+	full := forceFull || st.needsFullSync(fullEvery, now)
+	var since int64
+	if !full {
+		since = st.Watermark
 	}
-	resumes := client.Database("resumes").Collection("resumes_19")
-	for _, p := range pSlice {
-		if len(p.Profile.Name) == 0 {
-			continue
-		}
-		var test map[string]interface{}
-		err = resumes.FindOne(ctx, bson.D{
-			{"userid", p.ID.Hex()},
-		}).Decode(&test)
-		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				continue
-			}
-			return nil, err
-		}
-		url, ok := test["url"]
-		if !ok {
-			continue
-		}
-		p.Resume = url.(string)
-	}
-	return pSlice, nil
-}
-
-func saveToDB(pSlice []*hacker) error {
-	log.Debug("saving participants to database")
-	// delete all rows before inserting new ones
-	// NOTE: DELETE FROM participants RETURNING *
-	_, err := db.Conn.Exec("DELETE FROM participants")
+	log.Debugf("syncing participants, full: %v, since: %d", full, since)
+	recs, err := src.Fetch(context.Background(), since)
 	if err != nil {
-		return errors.Wrap(err, "participant: error while deleteing old participants")
+		return nil, err
 	}
-	for _, h := range pSlice {
-		query := `
-		INSERT INTO participants
-		(name, email, university, major, grad_year, github, linkedin, resume_url)
-		VALUES(:name, :email, :university, :major, :grad_year, :github, :linkedin, :resume)`
-		stmt, err := db.Conn.PrepareNamed(query)
-		if err != nil {
-			return errors.Wrap(err, "participant: error while inserting participant")
-		}
-		var p interface{}
-		gradYear := 0
-		if h.Profile.GradYear != "" {
-			gradYear, err = strconv.Atoi(h.Profile.GradYear)
-			if err != nil {
-				return errors.Wrap(err, "participant: error while converting from string to integer")
-			}
-		}
-		stmt.QueryRow(map[string]interface{}{
-			"name":       h.Profile.Name,
-			"email":      h.Email,
-			"university": h.Profile.University,
-			"major":      h.Confirmation.Major,
-			"grad_year":  gradYear,
-			"github":     h.Confirmation.Github,
-			"linkedin":   h.Confirmation.Linkedin,
-			"resume":     h.Resume,
-		}).Scan(&p)
+	rows, failed := records(recs)
+	st.advance(recs, failed, full, now)
+	res, err := saveToDB(rows, failed, full, st)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	res.Full = full
+	return res, nil
 }
 
-func validScheme(dbURI string) error {
-	url, err := url.Parse(dbURI)
+// List is a function that returns a page of participants that match the
+// given filter along with the token for the next page. The token is empty
+// when there are no more participants
+func List(f Filter, pg pagination.Page) ([]Participant, string, error) {
+	conds, args := f.conditions()
+	conds = append(conds, pg.Condition(args))
+	query := `SELECT ` + selectColumns + ` FROM participants ` +
+		where(conds...) + " " + pg.Clause(args)
+	rows, err := db.Conn.NamedQuery(query, args)
 	if err != nil {
-		return errors.Wrap(err, "participant: error while parsing url for sheme validation")
+		return nil, "", err
 	}
-	if len(url.Scheme) > 0 && url.Scheme != "mongodb" {
-		return ErrUnsupportedScheme
+	defer rows.Close()
+	pSlice, err := scanParticipants(rows)
+	if err != nil {
+		return nil, "", err
 	}
-	return nil
+	n, next := pg.Next(len(pSlice), func(i int) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: pSlice[i].CreatedAt, ID: pSlice[i].ID}
+	})
+	return pSlice[:n], next, nil
 }
 
-// List is a function that returns a slice of all participants
-func List() ([]Participant, error) {
-	query := `SELECT * FROM participants`
-	rows, err := db.Conn.Query(query)
-	if err != nil {
-		return nil, err
-	}
-	log.Debugf("%+v", rows)
+// scanParticipants scans all the rows of a query that selects selectColumns
+func scanParticipants(rows *sqlx.Rows) ([]Participant, error) {
 	var pSlice []Participant
 	for rows.Next() {
 		var p Participant
-		err := rows.Scan(&p.ID, &p.Name, &p.Email, &p.University, &p.Major, &p.GradYear, &p.Github,
-			&p.Linkedin, &p.Resume, &p.CreatedAt, &p.UpdatedAt)
+		err := rows.Scan(&p.ID, &p.ExternalID, &p.Name, &p.Email, &p.University, &p.Major, &p.GradYear, &p.Github,
+			&p.Linkedin, &p.Resume, &p.ResumeHash, &p.ResumeSize, &p.ResumeContentType,
+			&p.ResumeCachedURL, &p.CreatedAt, &p.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
 		pSlice = append(pSlice, p)
 	}
-	return pSlice, nil
+	return pSlice, rows.Err()
 }
@@ -0,0 +1,154 @@
+package participant
+
+import (
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/bson/bsontype"
+	"github.com/pkg/errors"
+)
+
+// QuillConfig describes where the users and resumes are stored in the mongodb
+// databases of quill and how the fields of a user map to a participant.
+// Field paths are dot separated, e.g. profile.school
+type QuillConfig struct {
+	Database          string `json:"database"`
+	UsersCollection   string `json:"users_collection"`
+	ResumesDatabase   string `json:"resumes_database"`
+	ResumesCollection string `json:"resumes_collection"`
+	// ResumeUserField is the field of a resume that holds the external ID
+	// of the participant it belongs to
+	ResumeUserField string `json:"resume_user_field"`
+	// ResumeURLField is the field of a resume that holds its url
+	ResumeURLField string       `json:"resume_url_field"`
+	Fields         FieldMapping `json:"fields"`
+}
+
+// FieldMapping maps every synced field of a participant to the path of the
+// field in a quill user. An empty path leaves the field of the participant
+// empty, an empty UpdatedAt path disables incremental syncs
+type FieldMapping struct {
+	ExternalID string `json:"external_id"`
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+	University string `json:"university"`
+	Major      string `json:"major"`
+	GradYear   string `json:"grad_year"`
+	Github     string `json:"github"`
+	Linkedin   string `json:"linkedin"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// DefaultQuillConfig returns the configuration for an unmodified quill
+// deployment of the current season
+func DefaultQuillConfig() *QuillConfig {
+	return &QuillConfig{
+		Database:          "quill",
+		UsersCollection:   "users",
+		ResumesDatabase:   "resumes",
+		ResumesCollection: "resumes_19",
+		ResumeUserField:   "userid",
+		ResumeURLField:    "url",
+		Fields: FieldMapping{
+			ExternalID: "_id",
+			Name:       "profile.name",
+			Email:      "email",
+			University: "profile.school",
+			Major:      "confirmation.major",
+			GradYear:   "profile.graduationYear",
+			Github:     "confirmation.github",
+			// quill has no linkedin field, the twitter field is used instead
+			Linkedin:  "confirmation.twitter",
+			UpdatedAt: "lastUpdated",
+		},
+	}
+}
+
+// LoadQuillConfig reads a JSON configuration file, the fields that are missing
+// from the file keep the values of DefaultQuillConfig
+func LoadQuillConfig(filename string) (*QuillConfig, error) {
+	cfg := DefaultQuillConfig()
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while opening quill config")
+	}
+	defer f.Close()
+	if err := json.NewDecoder(f).Decode(cfg); err != nil {
+		return nil, errors.Wrap(err, "participant: error while decoding quill config")
+	}
+	if len(cfg.Fields.ExternalID) == 0 {
+		return nil, errors.New("participant: quill config has no external_id field")
+	}
+	return cfg, nil
+}
+
+// record converts a quill user to a Record using the field mapping
+func (fm FieldMapping) record(doc bson.Raw) *Record {
+	return &Record{
+		ExternalID: lookupString(doc, fm.ExternalID),
+		Name:       lookupString(doc, fm.Name),
+		Email:      lookupString(doc, fm.Email),
+		University: lookupString(doc, fm.University),
+		Major:      lookupString(doc, fm.Major),
+		GradYear:   flexString(lookupString(doc, fm.GradYear)),
+		Github:     lookupString(doc, fm.Github),
+		Linkedin:   lookupString(doc, fm.Linkedin),
+		UpdatedAt:  lookupMillis(doc, fm.UpdatedAt),
+	}
+}
+
+func lookup(doc bson.Raw, path string) (bson.RawValue, bool) {
+	if len(path) == 0 {
+		return bson.RawValue{}, false
+	}
+	v, err := doc.LookupErr(strings.Split(path, ".")...)
+	if err != nil {
+		return bson.RawValue{}, false
+	}
+	return v, true
+}
+
+// lookupString returns the value of a field as a string, object IDs are
+// converted to their hex representation and numbers are formatted
+func lookupString(doc bson.Raw, path string) string {
+	v, ok := lookup(doc, path)
+	if !ok {
+		return ""
+	}
+	switch v.Type {
+	case bsontype.String:
+		return v.StringValue()
+	case bsontype.ObjectID:
+		return v.ObjectID().Hex()
+	case bsontype.Int32:
+		return strconv.FormatInt(int64(v.Int32()), 10)
+	case bsontype.Int64:
+		return strconv.FormatInt(v.Int64(), 10)
+	case bsontype.Double:
+		return strconv.FormatFloat(v.Double(), 'f', -1, 64)
+	}
+	return ""
+}
+
+// lookupMillis returns the value of a field that is either a date or a number
+// of milliseconds since the epoch
+func lookupMillis(doc bson.Raw, path string) int64 {
+	v, ok := lookup(doc, path)
+	if !ok {
+		return 0
+	}
+	switch v.Type {
+	case bsontype.DateTime:
+		return v.DateTime()
+	case bsontype.Int32:
+		return int64(v.Int32())
+	case bsontype.Int64:
+		return v.Int64()
+	case bsontype.Double:
+		return int64(v.Double())
+	}
+	return 0
+}
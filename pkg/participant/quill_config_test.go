@@ -0,0 +1,41 @@
+package participant
+
+import (
+	"testing"
+
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/bson/primitive"
+)
+
+func TestDefaultFieldMapping(t *testing.T) {
+	id := primitive.NewObjectID()
+	bb, err := bson.Marshal(bson.D{
+		{Key: "_id", Value: id},
+		{Key: "email", Value: "jane@auburn.edu"},
+		{Key: "lastUpdated", Value: float64(1549708800000)},
+		{Key: "profile", Value: bson.D{
+			{Key: "name", Value: "Jane Doe"},
+			{Key: "school", Value: "Auburn University"},
+			{Key: "graduationYear", Value: "2020"},
+		}},
+		{Key: "confirmation", Value: bson.D{
+			{Key: "twitter", Value: "linkedin.com/in/jane"},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	r := DefaultQuillConfig().Fields.record(bson.Raw(bb))
+	if r.ExternalID != id.Hex() {
+		t.Fatalf("expected external id %s got: %s", id.Hex(), r.ExternalID)
+	}
+	if r.Name != "Jane Doe" || r.University != "Auburn University" || r.GradYear != "2020" {
+		t.Fatalf("unexpected profile fields: %+v", r)
+	}
+	if r.Linkedin != "linkedin.com/in/jane" || r.Github != "" {
+		t.Fatalf("unexpected confirmation fields: %+v", r)
+	}
+	if r.UpdatedAt != 1549708800000 {
+		t.Fatalf("unexpected updated at: %d", r.UpdatedAt)
+	}
+}
@@ -1,101 +1,248 @@
 package participant
 
 import (
-	"archive/tar"
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
+	"mime"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
-var c = http.DefaultClient
+// maxResumeSize is the largest resume that is downloaded, anything larger is
+// most likely not a resume
+const maxResumeSize = 20 << 20
 
-// AllResumes is a function that lists all participants all downloads their
-// resumes from google cloud and provides a byte sequence in tar format
-func AllResumes() ([]byte, error) {
-	participants, err := List()
-	if err != nil {
-		return nil, err
+// resumeContentTypes are the content types accepted as a resume, anything
+// else such as the HTML of an error page is reported as a failure
+var resumeContentTypes = map[string]bool{
+	"application/pdf":                         true,
+	"application/msword":                      true,
+	"application/rtf":                         true,
+	"application/vnd.oasis.opendocument.text": true,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": true,
+	"text/plain": true,
+	"text/rtf":   true,
+}
+
+// DefaultDownloader is the downloader used for all the resumes downloaded by
+// this package, it can be replaced before the server is started
+var DefaultDownloader = NewDownloader(8, 30*time.Second, 3)
+
+// Downloader downloads resumes using a bounded pool of workers. Every request
+// has its own timeout and failed requests are retried with an exponential
+// backoff
+type Downloader struct {
+	client  *http.Client
+	workers int
+	timeout time.Duration
+	retries int
+	backoff time.Duration
+}
+
+// NewDownloader returns a Downloader that downloads at most workers resumes at
+// a time, gives up on a request after timeout and retries a failed request
+// retries times
+func NewDownloader(workers int, timeout time.Duration, retries int) *Downloader {
+	if workers < 1 {
+		workers = 1
 	}
-	urls := []string{}
-	for _, p := range participants {
-		if len(p.Resume) == 0 {
-			continue
-		}
-		urls = append(urls, p.Resume)
+	if retries < 0 {
+		retries = 0
 	}
-	bt, err := Download(urls)
-	if err != nil {
-		return nil, err
+	return &Downloader{
+		client:  http.DefaultClient,
+		workers: workers,
+		timeout: timeout,
+		retries: retries,
+		backoff: 500 * time.Millisecond,
 	}
-	var tbuf bytes.Buffer
-	tw := tar.NewWriter(&tbuf)
-	for i, bb := range bt {
-		hdr := &tar.Header{
-			Name: fmt.Sprintf("resume-%d.pdf", i),
-			Mode: 0600,
-			Size: int64(len(bb)),
-		}
-		if err := tw.WriteHeader(hdr); err != nil {
-			return nil, errors.Wrap(err,
-				"pkg/participant: error while writing header.")
-		}
-		if _, err := tw.Write(bb); err != nil {
-			return nil, errors.Wrap(err,
-				"pkg/participant: error while wrint to tar archive")
+}
+
+// ResumeResult is the outcome of downloading a single resume
+type ResumeResult struct {
+	// Index is the index of the url in the urls that were downloaded
+	Index       int
+	URL         string
+	Data        []byte
+	ContentType string
+	Err         error
+}
+
+// ResumeResults is the outcome of downloading a set of resumes
+type ResumeResults []ResumeResult
+
+// Failed returns the results of the resumes that could not be downloaded
+func (rs ResumeResults) Failed() ResumeResults {
+	failed := ResumeResults{}
+	for _, r := range rs {
+		if r.Err != nil {
+			failed = append(failed, r)
 		}
 	}
-	if err := tw.Close(); err != nil {
-		return nil, errors.Wrap(err,
-			"pkg/participant: error while closing tar archive")
-	}
-	return tbuf.Bytes(), nil
+	return failed
+}
+
+// StatusError is the error of a download that got a response other than
+// 200 OK
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("participant: unexpected status %d while downloading %s",
+		e.StatusCode, e.URL)
+}
+
+// ContentTypeError is the error of a download that is not a resume
+type ContentTypeError struct {
+	URL         string
+	ContentType string
 }
 
-// Download ia function that will allow you to download all the resumes at once
-func Download(urls []string) ([][]byte, error) {
-	bufCh := make(chan []byte, len(urls))
-	urlCh := make(chan string)
+func (e *ContentTypeError) Error() string {
+	return fmt.Sprintf("participant: unexpected content type %s while downloading %s",
+		e.ContentType, e.URL)
+}
 
-	for i := 0; i < len(urls); i++ {
-		go fetch(urlCh, bufCh)
+// AllResumes is a function that lists all participants that match the filter
+// and downloads their resumes from google cloud and provides a byte sequence
+// in tar format along with the resumes that could not be downloaded
+func AllResumes(f Filter) ([]byte, []Failure, error) {
+	var tbuf bytes.Buffer
+	failed, err := WriteResumes(context.Background(), &tbuf, FormatTar, f)
+	if err != nil {
+		return nil, nil, err
 	}
+	return tbuf.Bytes(), failed, nil
+}
 
-	for _, url := range urls {
-		urlCh <- url
+// Download ia function that will allow you to download all the resumes at once.
+// The results are in the same order as the urls, the resumes that could not
+// be downloaded have their Err set
+func Download(ctx context.Context, urls []string) (ResumeResults, error) {
+	return DefaultDownloader.Download(ctx, urls)
+}
+
+// Download downloads all the urls and returns once every url has either been
+// downloaded or has failed. An error is only returned when ctx is done
+func (d *Downloader) Download(ctx context.Context, urls []string) (ResumeResults, error) {
+	rs := make(ResumeResults, len(urls))
+	for r := range d.Stream(ctx, urls) {
+		rs[r.Index] = r
 	}
-	close(urlCh)
-	bt := [][]byte{} // bt is a bytes table that has all the resumes
-	for i := 0; i < len(urls); i++ {
-		bb := <-bufCh
-		bt = append(bt, bb)
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
-	return bt, nil
+	return rs, nil
 }
 
-func fetch(urlCh <-chan string, bufCh chan<- []byte) {
-	url, ok := <-urlCh
-	if !ok {
-		return
+// Stream downloads all the urls and sends every result on the returned channel
+// as soon as it is available. The channel is closed after all the urls have
+// been downloaded or ctx is done
+func (d *Downloader) Stream(ctx context.Context, urls []string) <-chan ResumeResult {
+	jobs := make(chan int)
+	out := make(chan ResumeResult)
+	var wg sync.WaitGroup
+	for i := 0; i < d.workers && i < len(urls); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				bb, contentType, err := d.get(ctx, urls[idx])
+				select {
+				case out <- ResumeResult{Index: idx, URL: urls[idx], Data: bb,
+					ContentType: contentType, Err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
 	}
-	bb, err := getBytes(url)
-	if err != nil {
-		return
+	go func() {
+		defer close(jobs)
+		for i := range urls {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// get downloads a single resume and retries it when the error is temporary
+func (d *Downloader) get(ctx context.Context, url string) ([]byte, string, error) {
+	backoff := d.backoff
+	for attempt := 0; ; attempt++ {
+		bb, contentType, err := d.getOnce(ctx, url)
+		if err == nil || attempt >= d.retries || ctx.Err() != nil || !retryable(err) {
+			return bb, contentType, err
+		}
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return nil, "", ctx.Err()
+		}
 	}
-	bufCh <- bb
 }
 
-func getBytes(url string) ([]byte, error) {
-	resp, err := c.Get(url)
+// getOnce downloads a resume along with its content type, the content type is
+// sniffed from the resume when the server does not send one
+func (d *Downloader) getOnce(ctx context.Context, url string) ([]byte, string, error) {
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		return nil, err
+		return nil, "", errors.Wrap(err, "participant: invalid resume url")
+	}
+	resp, err := d.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, "", err
 	}
 	defer resp.Body.Close()
-	bb, err := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", &StatusError{URL: url, StatusCode: resp.StatusCode}
+	}
+	bb, err := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, maxResumeSize))
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if mt, _, err := mime.ParseMediaType(contentType); err == nil {
+		contentType = mt
+	}
+	if contentType == "" || contentType == "application/octet-stream" {
+		contentType, _, _ = mime.ParseMediaType(http.DetectContentType(bb))
+	}
+	if !resumeContentTypes[contentType] {
+		return nil, "", &ContentTypeError{URL: url, ContentType: contentType}
+	}
+	return bb, contentType, nil
+}
+
+// retryable reports whether a failed download is worth retrying, only
+// network errors and responses from overloaded or failing servers are retried
+func retryable(err error) bool {
+	switch e := err.(type) {
+	case *StatusError:
+		return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+	case *ContentTypeError:
+		return false
 	}
-	return bb, nil
+	return true
 }
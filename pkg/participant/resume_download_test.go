@@ -1,26 +1,93 @@
 package participant
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
-func TestGetBytes(t *testing.T) {
-	url := "https://auburnhacks.com/metadata"
-	_, err := getBytes(url)
+var testPDF = []byte("%PDF-1.4\n%test resume\n")
+
+func newTestDownloader(workers, retries int) *Downloader {
+	d := NewDownloader(workers, time.Second, retries)
+	d.backoff = time.Millisecond
+	return d
+}
+
+func TestGetOnce(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(testPDF)
+	}))
+	defer ts.Close()
+	bb, contentType, err := newTestDownloader(1, 0).getOnce(context.Background(), ts.URL)
 	if err != nil {
 		t.Fatalf("error: %v", err)
 	}
+	if string(bb) != string(testPDF) || contentType != "application/pdf" {
+		t.Fatalf("unexpected resume %q with content type %s", bb, contentType)
+	}
 }
 
 func TestDownload(t *testing.T) {
+	var flaky int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok.pdf", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(testPDF)
+	})
+	mux.HandleFunc("/flaky.pdf", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&flaky, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(testPDF)
+	})
+	mux.HandleFunc("/html.pdf", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html><body>not found</body></html>"))
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
 	urls := []string{
-		"https://storage.googleapis.com/resumes_19/54708071-4f77-4f62-9bed-6a903e4248c9.pdf",
-		"https://storage.googleapis.com/resumes_19/54708071-4f77-4f62-9bed-6a903e4248c9.pdf",
-		"https://storage.googleapis.com/resumes_19/54708071-4f77-4f62-9bed-6a903e4248c9.pdf",
-		"https://storage.googleapis.com/resumes_19/54708071-4f77-4f62-9bed-6a903e4248c9.pdf",
+		ts.URL + "/ok.pdf",
+		ts.URL + "/missing.pdf",
+		ts.URL + "/flaky.pdf",
+		ts.URL + "/html.pdf",
+		ts.URL + "/ok.pdf",
 	}
-	_, err := Download(urls)
+	rs, err := newTestDownloader(2, 3).Download(context.Background(), urls)
 	if err != nil {
 		t.Fatalf("error: %v", err)
 	}
+	if len(rs) != len(urls) {
+		t.Fatalf("expected %d results got %d", len(urls), len(rs))
+	}
+	failed := rs.Failed()
+	if len(failed) != 2 || failed[0].Index != 1 || failed[1].Index != 3 {
+		t.Fatalf("unexpected failures: %+v", failed)
+	}
+	if e, ok := failed[0].Err.(*StatusError); !ok || e.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 status error got: %v", failed[0].Err)
+	}
+	if _, ok := failed[1].Err.(*ContentTypeError); !ok {
+		t.Fatalf("expected a content type error got: %v", failed[1].Err)
+	}
+	if n := atomic.LoadInt32(&flaky); n != 3 {
+		t.Fatalf("expected 3 attempts for the flaky resume got %d", n)
+	}
+}
+
+func TestDownloadCancelled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	urls := []string{ts.URL, ts.URL, ts.URL}
+	if _, err := newTestDownloader(1, 3).Download(ctx, urls); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded got: %v", err)
+	}
 }
@@ -0,0 +1,288 @@
+package participant
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// columns are the columns of the participants table that are synced from the
+// external database in the order of the values of a record
+var columns = []string{"external_id", "name", "email", "university", "major",
+	"grad_year", "github", "linkedin", "resume_url"}
+
+// MaxDeleteShare is the largest share of the participants that a full sync is
+// allowed to delete. A source that is suddenly missing more participants than
+// that is most likely misconfigured
+var MaxDeleteShare = 0.5
+
+// RecordError is an error for a single participant that could not be synced
+type RecordError struct {
+	ExternalID string
+	Err        error
+	// updatedAt is the update time of the record, the watermark of a sync
+	// does not move past it so that the record is fetched again
+	updatedAt int64
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("participant %s: %v", e.ExternalID, e.Err)
+}
+
+// Result is the outcome of saving the participants of a sync to the database
+type Result struct {
+	// Full is true when every participant was fetched from the source
+	Full bool
+	// Saved is the number of participants that were inserted or updated
+	Saved int
+	// Deleted is the number of participants that were deleted since they
+	// are no longer in the external database
+	Deleted int
+	// Failed has an error for every participant that could not be synced
+	Failed []*RecordError
+	// Mirrored is the number of resumes that were mirrored after the
+	// participants were saved
+	Mirrored int
+	// MirrorFailed has every resume that could not be mirrored
+	MirrorFailed []Failure
+}
+
+// records converts records from a source to the values of the rows that have
+// to be saved to the database. Records that cannot be converted or that have
+// values postgres would reject are returned as errors instead of failing all
+// the other records.
+// NOTE: any other error from the database still fails the whole sync
+func records(recs []*Record) ([][]interface{}, []*RecordError) {
+	rows := [][]interface{}{}
+	failed := []*RecordError{}
+	seen := map[string]bool{}
+	for _, r := range recs {
+		fail := func(err error) {
+			failed = append(failed, &RecordError{r.ExternalID, err, r.UpdatedAt})
+		}
+		if len(r.ExternalID) == 0 {
+			fail(errors.New("missing id"))
+			continue
+		}
+		if seen[r.ExternalID] {
+			fail(errors.New("duplicate id"))
+			continue
+		}
+		seen[r.ExternalID] = true
+		gradYear, err := r.gradYear()
+		if err != nil {
+			fail(errors.Wrap(err, "invalid graduation year"))
+			continue
+		}
+		if gradYear < 0 || gradYear > math.MaxInt32 {
+			fail(errors.New("graduation year out of range"))
+			continue
+		}
+		row := []interface{}{
+			r.ExternalID,
+			r.Name,
+			r.Email,
+			r.University,
+			r.Major,
+			gradYear,
+			r.Github,
+			r.Linkedin,
+			r.Resume,
+		}
+		if err := checkValues(row); err != nil {
+			fail(err)
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, failed
+}
+
+// maxValueLength is the maximum length in bytes of a single value of a
+// record, longer values do not fit in the index on external_id
+const maxValueLength = 2048
+
+// checkValues returns an error for the first value of a row that postgres
+// would reject, so that a single bad record fails on its own instead of
+// aborting the copy of every other record
+func checkValues(row []interface{}) error {
+	for i, v := range row {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		switch {
+		case len(s) > maxValueLength:
+			return errors.Errorf("%s is longer than %d bytes", columns[i], maxValueLength)
+		case !utf8.ValidString(s):
+			return errors.Errorf("%s is not valid UTF-8", columns[i])
+		case strings.ContainsRune(s, 0):
+			return errors.Errorf("%s contains a NUL character", columns[i])
+		}
+	}
+	return nil
+}
+
+// saveToDB upserts all the participants on their external ID so that the ID of
+// a participant and all the data linked to it survives a sync. During a full
+// sync the participants that are no longer in the external database are deleted
+// unless too many of them are missing, see deleteMissing.
+// The participants are copied into a temporary table and the whole sync is
+// applied in a single transaction along with the sync state so that the
+// participants are never seen in a partially synced state
+func saveToDB(rows [][]interface{}, failed []*RecordError, full bool, st *syncState) (*Result, error) {
+	log.Debug("saving participants to database")
+	res := &Result{Failed: failed}
+	// the participants that failed are still in the external database and
+	// must not be deleted
+	failedIDs := make([]string, len(failed))
+	for i, f := range failed {
+		failedIDs[i] = f.ExternalID
+	}
+
+	tx, err := db.Conn.Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while starting transaction")
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+	CREATE TEMPORARY TABLE participants_sync (
+		external_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		email TEXT NOT NULL,
+		university TEXT NOT NULL,
+		major TEXT NOT NULL,
+		grad_year INT NOT NULL,
+		github TEXT NOT NULL,
+		linkedin TEXT NOT NULL,
+		resume_url TEXT NOT NULL
+	) ON COMMIT DROP`)
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while creating sync table")
+	}
+	stmt, err := tx.Prepare(pq.CopyIn("participants_sync", columns...))
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while preparing copy")
+	}
+	for _, row := range rows {
+		if _, err := stmt.Exec(row...); err != nil {
+			stmt.Close()
+			return nil, errors.Wrap(err, "participant: error while copying participant")
+		}
+	}
+	if _, err := stmt.Exec(); err != nil {
+		stmt.Close()
+		return nil, errors.Wrap(err, "participant: error while flushing copy")
+	}
+	if err := stmt.Close(); err != nil {
+		return nil, errors.Wrap(err, "participant: error while closing copy")
+	}
+
+	if err := backfillExternalIDs(tx); err != nil {
+		return nil, err
+	}
+	r, err := tx.Exec(`
+	INSERT INTO participants
+	(external_id, name, email, university, major, grad_year, github, linkedin, resume_url)
+	SELECT external_id, name, email, university, major, grad_year, github, linkedin, resume_url
+	FROM participants_sync
+	ON CONFLICT (external_id) DO UPDATE
+	SET name = EXCLUDED.name, email = EXCLUDED.email, university = EXCLUDED.university,
+	major = EXCLUDED.major, grad_year = EXCLUDED.grad_year, github = EXCLUDED.github,
+	linkedin = EXCLUDED.linkedin, resume_url = EXCLUDED.resume_url, updated_at = NOW()`)
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while upserting participants")
+	}
+	saved, err := r.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	res.Saved = int(saved)
+
+	if full {
+		deleted, err := deleteMissing(tx, len(rows)+len(failed), failedIDs)
+		if err != nil {
+			return nil, err
+		}
+		res.Deleted = deleted
+	}
+	if err := st.save(tx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "participant: error while committing sync")
+	}
+	return res, nil
+}
+
+// backfillExternalIDs sets the external ID of the participants that were
+// synced before external IDs existed by matching their email with the synced
+// participants, so that they are updated instead of inserted again and keep
+// their shortlists and notes. Emails that are not unique are left alone
+func backfillExternalIDs(tx *sqlx.Tx) error {
+	_, err := tx.Exec(`
+	UPDATE participants p SET external_id = s.external_id
+	FROM participants_sync s
+	WHERE p.external_id IS NULL AND lower(p.email) = lower(s.email)
+	AND (SELECT COUNT(*) FROM participants o WHERE lower(o.email) = lower(p.email)) = 1
+	AND (SELECT COUNT(*) FROM participants_sync o WHERE lower(o.email) = lower(s.email)) = 1
+	AND NOT EXISTS (SELECT 1 FROM participants o WHERE o.external_id = s.external_id)`)
+	if err != nil {
+		return errors.Wrap(err, "participant: error while backfilling external ids")
+	}
+	return nil
+}
+
+// deleteMissing deletes the participants that are missing from a full sync
+// and returns how many were deleted. Nothing is deleted when the source
+// returned no participants or when the delete would remove more than
+// MaxDeleteShare of the participants since all the data linked to them is
+// deleted along with them
+func deleteMissing(tx *sqlx.Tx, fetched int, failedIDs []string) (int, error) {
+	const missing = `NOT EXISTS (SELECT 1 FROM participants_sync s WHERE s.external_id = p.external_id)
+		AND NOT (COALESCE(p.external_id, '') = ANY($1))`
+	var total, deleting int
+	err := tx.QueryRowx(`SELECT COUNT(*), COUNT(*) FILTER (WHERE `+missing+`)
+	FROM participants p`, pq.Array(failedIDs)).Scan(&total, &deleting)
+	if err != nil {
+		return 0, errors.Wrap(err, "participant: error while counting old participants")
+	}
+	if err := checkDelete(fetched, total, deleting); err != nil {
+		log.Warnf("not deleting old participants: %v", err)
+		return 0, nil
+	}
+	r, err := tx.Exec(`DELETE FROM participants p WHERE `+missing, pq.Array(failedIDs))
+	if err != nil {
+		return 0, errors.Wrap(err, "participant: error while deleting old participants")
+	}
+	deleted, err := r.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(deleted), nil
+}
+
+// checkDelete returns an error when deleting the participants that are
+// missing from a full sync is not safe
+func checkDelete(fetched, total, deleting int) error {
+	if deleting == 0 {
+		return nil
+	}
+	if fetched == 0 {
+		return errors.Errorf("the source returned no participants, %d would be deleted", deleting)
+	}
+	if float64(deleting) > MaxDeleteShare*float64(total) {
+		return errors.Errorf("%d of %d participants would be deleted, more than %.0f%%",
+			deleting, total, MaxDeleteShare*100)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package participant
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRecords(t *testing.T) {
+	valid := &Record{ExternalID: "5c5e1d3f", GradYear: "2020"}
+	invalid := &Record{ExternalID: "5c5e1d40", GradYear: "spring 2020"}
+	missing := &Record{}
+
+	rows, failed := records([]*Record{valid, invalid, missing, valid})
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row got: %d", len(rows))
+	}
+	if rows[0][0] != valid.ExternalID || rows[0][5] != 2020 {
+		t.Fatalf("unexpected row: %v", rows[0])
+	}
+	if len(rows[0]) != len(columns) {
+		t.Fatalf("expected %d values got: %d", len(columns), len(rows[0]))
+	}
+	if len(failed) != 3 {
+		t.Fatalf("expected 3 failed records got: %v", failed)
+	}
+	if failed[0].ExternalID != invalid.ExternalID {
+		t.Fatalf("expected the invalid graduation year to fail first got: %v", failed[0])
+	}
+}
+
+func TestRecordsRejectsValuesPostgresWouldReject(t *testing.T) {
+	recs := []*Record{
+		{ExternalID: "long", Name: strings.Repeat("a", maxValueLength+1)},
+		{ExternalID: "utf8", Major: "\xff\xfe"},
+		{ExternalID: "nul", Email: "a\x00@example.com"},
+		{ExternalID: "year", GradYear: "99999999999"},
+		{ExternalID: "valid", Name: "Jane Doe"},
+	}
+	rows, failed := records(recs)
+	if len(rows) != 1 || rows[0][0] != "valid" {
+		t.Fatalf("expected only the valid row got: %v", rows)
+	}
+	if len(failed) != 4 {
+		t.Fatalf("expected 4 failed records got: %v", failed)
+	}
+}
+
+func TestCheckDelete(t *testing.T) {
+	tests := []struct {
+		fetched, total, deleting int
+		ok                       bool
+	}{
+		{fetched: 100, total: 100, deleting: 0, ok: true},
+		{fetched: 90, total: 100, deleting: 10, ok: true},
+		{fetched: 0, total: 0, deleting: 0, ok: true},
+		{fetched: 0, total: 100, deleting: 100, ok: false},
+		{fetched: 10, total: 100, deleting: 90, ok: false},
+	}
+	for _, tt := range tests {
+		err := checkDelete(tt.fetched, tt.total, tt.deleting)
+		if (err == nil) != tt.ok {
+			t.Errorf("checkDelete(%d, %d, %d) = %v", tt.fetched, tt.total, tt.deleting, err)
+		}
+	}
+}
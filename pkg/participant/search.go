@@ -0,0 +1,80 @@
+package participant
+
+import (
+	"strings"
+
+	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultSearchLimit is the number of results returned by Search when no
+	// limit is given
+	DefaultSearchLimit = 20
+	// MaxSearchLimit is the largest number of results returned by Search
+	MaxSearchLimit = 100
+)
+
+// The markers around the matched keywords of a snippet, they are removed from
+// the resume text first so that they only ever mark a match
+const (
+	SnippetStart = "«"
+	SnippetStop  = "»"
+)
+
+// ErrEmptyQuery is returned when a search has no keywords
+var ErrEmptyQuery = errors.New("participant: search query should not be empty")
+
+// SearchResult is a participant that matched a search
+type SearchResult struct {
+	Participant
+	Rank float32
+	// Snippet is a plain text excerpt of the resume with the matched keywords
+	// wrapped in SnippetStart and SnippetStop. It is not HTML and must be
+	// escaped before it is rendered as HTML
+	Snippet string
+}
+
+// Search returns the participants that match the keywords in query and the
+// filter ranked by how well they match. The name of a participant ranks higher
+// than the major and university which rank higher than the resume
+func Search(query string, f Filter, limit int) ([]SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if len(query) == 0 {
+		return nil, ErrEmptyQuery
+	}
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+	conds, args := f.conditions()
+	conds = append(conds, "resume_tsv @@ q")
+	args["search"] = query
+	args["limit"] = limit
+	args["markers"] = SnippetStart + SnippetStop
+	rows, err := db.Conn.NamedQuery(`
+	SELECT `+selectColumns+`, ts_rank(resume_tsv, q) AS rank,
+	ts_headline('english', translate(resume_text, :markers, ''), q,
+		'StartSel="`+SnippetStart+`", StopSel="`+SnippetStop+`", MaxFragments=2, MaxWords=20, MinWords=5')
+	FROM participants, plainto_tsquery('english', :search) q `+where(conds...)+`
+	ORDER BY rank DESC, id LIMIT :limit`, args)
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while searching participants")
+	}
+	defer rows.Close()
+	results := []SearchResult{}
+	for rows.Next() {
+		var r SearchResult
+		p := &r.Participant
+		err := rows.Scan(&p.ID, &p.ExternalID, &p.Name, &p.Email, &p.University, &p.Major, &p.GradYear, &p.Github,
+			&p.Linkedin, &p.Resume, &p.ResumeHash, &p.ResumeSize, &p.ResumeContentType,
+			&p.ResumeCachedURL, &p.CreatedAt, &p.UpdatedAt, &r.Rank, &r.Snippet)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
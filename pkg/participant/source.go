@@ -0,0 +1,65 @@
+package participant
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Source is an external system that participants are synced from, such as the
+// database of the registration system of a hackathon
+type Source interface {
+	// Name identifies the source, the sync state is stored under this name
+	Name() string
+	// Fetch returns all the participants that were updated at or after since
+	// (in milliseconds since the epoch). Every participant is returned when
+	// since is zero. A source that cannot tell when a participant was updated
+	// returns every participant
+	Fetch(ctx context.Context, since int64) ([]*Record, error)
+}
+
+// Record is a participant as it is fetched from a Source
+type Record struct {
+	// ExternalID is the ID of the participant in the source
+	ExternalID string     `json:"external_id"`
+	Name       string     `json:"name"`
+	Email      string     `json:"email"`
+	University string     `json:"university"`
+	Major      string     `json:"major"`
+	GradYear   flexString `json:"grad_year"`
+	Github     string     `json:"github"`
+	Linkedin   string     `json:"linkedin"`
+	Resume     string     `json:"resume"`
+	// UpdatedAt is the time of the last update to the participant in the source
+	// in milliseconds since the epoch, zero if the source does not track it
+	UpdatedAt int64 `json:"updated_at"`
+}
+
+// flexString is a string that can also be decoded from a JSON number since
+// registration systems do not agree on the type of fields like grad_year
+type flexString string
+
+func (f *flexString) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*f = flexString(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	*f = flexString(n.String())
+	return nil
+}
+
+// gradYear converts the graduation year of a record to an integer, an empty
+// graduation year is zero
+func (r *Record) gradYear() (int, error) {
+	gy := strings.TrimSpace(string(r.GradYear))
+	if len(gy) == 0 {
+		return 0, nil
+	}
+	return strconv.Atoi(gy)
+}
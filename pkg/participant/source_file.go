@@ -0,0 +1,118 @@
+package participant
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedFile is an error returned when a file source is neither a
+// CSV nor a JSON file
+var ErrUnsupportedFile = errors.New("participant: only .csv and .json files can be imported")
+
+// fileSource is a Source that imports participants from a CSV or JSON file
+// exported from a registration system. The file is read again on every sync
+type fileSource struct {
+	path string
+}
+
+// NewFileSource returns a Source that imports participants from a file.
+// A JSON file has to contain an array of records and a CSV file needs a
+// header row with the names of the JSON fields of a Record
+func NewFileSource(path string) (Source, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv", ".json":
+	default:
+		return nil, ErrUnsupportedFile
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, errors.Wrap(err, "participant: error while opening source file")
+	}
+	return &fileSource{path: path}, nil
+}
+
+func (fs *fileSource) Name() string {
+	return "file:" + fs.path
+}
+
+// Fetch reads every participant from the file, since is ignored because
+// upserting a participant that did not change is harmless
+func (fs *fileSource) Fetch(ctx context.Context, since int64) ([]*Record, error) {
+	f, err := os.Open(fs.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while opening source file")
+	}
+	defer f.Close()
+	if strings.ToLower(filepath.Ext(fs.path)) == ".json" {
+		return decodeJSON(f)
+	}
+	return decodeCSV(f)
+}
+
+func decodeJSON(r io.Reader) ([]*Record, error) {
+	recs := []*Record{}
+	if err := json.NewDecoder(r).Decode(&recs); err != nil {
+		return nil, errors.Wrap(err, "participant: error while decoding json records")
+	}
+	return recs, nil
+}
+
+func decodeCSV(r io.Reader) ([]*Record, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while reading csv header")
+	}
+	index := map[string]int{}
+	for i, h := range header {
+		index[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	if _, ok := index["external_id"]; !ok {
+		return nil, errors.New("participant: csv header has no external_id column")
+	}
+	recs := []*Record{}
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "participant: error while reading csv row")
+		}
+		field := func(name string) string {
+			i, ok := index[name]
+			if !ok || i >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[i])
+		}
+		rec := &Record{
+			ExternalID: field("external_id"),
+			Name:       field("name"),
+			Email:      field("email"),
+			University: field("university"),
+			Major:      field("major"),
+			GradYear:   flexString(field("grad_year")),
+			Github:     field("github"),
+			Linkedin:   field("linkedin"),
+			Resume:     field("resume"),
+		}
+		if updatedAt := field("updated_at"); len(updatedAt) > 0 {
+			rec.UpdatedAt, err = strconv.ParseInt(updatedAt, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err,
+					"participant: invalid updated_at for %s", rec.ExternalID)
+			}
+		}
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
@@ -0,0 +1,45 @@
+package participant
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecodeCSV(t *testing.T) {
+	in := "external_id,name,grad_year,resume\n" +
+		"a1, Jane Doe ,2020,https://example.com/a1.pdf\n" +
+		"a2,John Doe,,\n"
+	recs, err := decodeCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if len(recs) != 2 {
+		t.Fatalf("expected 2 records got: %d", len(recs))
+	}
+	if recs[0].Name != "Jane Doe" || recs[0].Resume != "https://example.com/a1.pdf" {
+		t.Fatalf("unexpected record: %+v", recs[0])
+	}
+	if gy, err := recs[0].gradYear(); err != nil || gy != 2020 {
+		t.Fatalf("expected graduation year 2020 got: %d %v", gy, err)
+	}
+	if gy, err := recs[1].gradYear(); err != nil || gy != 0 {
+		t.Fatalf("expected no graduation year got: %d %v", gy, err)
+	}
+}
+
+func TestDecodeCSVWithoutID(t *testing.T) {
+	if _, err := decodeCSV(strings.NewReader("name,email\n")); err == nil {
+		t.Fatal("expected an error for a csv without external_id")
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	in := `[{"external_id": "a1", "grad_year": 2021}, {"external_id": "a2", "grad_year": "2022"}]`
+	recs, err := decodeJSON(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if recs[0].GradYear != "2021" || recs[1].GradYear != "2022" {
+		t.Fatalf("unexpected graduation years: %s %s", recs[0].GradYear, recs[1].GradYear)
+	}
+}
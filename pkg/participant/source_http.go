@@ -0,0 +1,67 @@
+package participant
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// httpSource is a Source that fetches participants from an HTTP endpoint
+// that responds with a JSON array of records
+type httpSource struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewHTTPSource returns a Source for a generic HTTP JSON endpoint. When the
+// sync is incremental the endpoint is called with a since query parameter
+// (in milliseconds since the epoch) and may only return the participants
+// that were updated at or after that time
+func NewHTTPSource(endpoint string) (Source, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while parsing source url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, ErrUnsupportedScheme
+	}
+	return &httpSource{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+func (hs *httpSource) Name() string {
+	return "http:" + hs.endpoint
+}
+
+func (hs *httpSource) Fetch(ctx context.Context, since int64) ([]*Record, error) {
+	u, err := url.Parse(hs.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if since > 0 {
+		q := u.Query()
+		q.Set("since", strconv.FormatInt(since, 10))
+		u.RawQuery = q.Encode()
+	}
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := hs.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while fetching participants")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("participant: source responded with %s", resp.Status)
+	}
+	return decodeJSON(resp.Body)
+}
@@ -0,0 +1,140 @@
+package participant
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/mongodb/mongo-go-driver/bson"
+	"github.com/mongodb/mongo-go-driver/mongo"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrUnsupportedScheme is a error return by a function when the url contains a
+	// protocol scheme that is unsupported by the system right now
+	ErrUnsupportedScheme = errors.New("participant: this database scheme not supported")
+)
+
+// quillSource is a Source that syncs the users of quill from its mongodb
+// database and their resumes from the resumes database
+type quillSource struct {
+	quillURI   string
+	resumesURI string
+	cfg        *QuillConfig
+}
+
+// NewQuillSource returns a Source for the mongodb databases of quill, the
+// databases, collections and fields that are synced are read from cfg
+func NewQuillSource(quillURI, resumesURI string, cfg *QuillConfig) (Source, error) {
+	log.Debugf("will sync from external db: %s, %s", quillURI, resumesURI)
+	if err := validScheme(quillURI); err != nil {
+		return nil, err
+	}
+	if err := validScheme(resumesURI); err != nil {
+		return nil, err
+	}
+	return &quillSource{
+		quillURI:   quillURI,
+		resumesURI: resumesURI,
+		cfg:        cfg,
+	}, nil
+}
+
+func (qs *quillSource) Name() string {
+	return "quill"
+}
+
+func (qs *quillSource) Fetch(ctx context.Context, since int64) ([]*Record, error) {
+	recs, err := qs.fetchParticipants(ctx, since)
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while fetching participants")
+	}
+	if err := qs.fetchResumes(ctx, recs); err != nil {
+		return nil, errors.Wrap(err, "participant: error while fetching resumes")
+	}
+	return recs, nil
+}
+
+// fetchParticipants fetches all the users that were updated at or after since,
+// every user is fetched when since is zero
+func (qs *quillSource) fetchParticipants(ctx context.Context, since int64) ([]*Record, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	log.Debug("creating mongo client")
+	client, err := mongo.NewClient(qs.quillURI)
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while creating mongo client")
+	}
+	log.Debug("connecting to mongodb")
+	if err := client.Connect(ctx); err != nil {
+		return nil, errors.Wrap(err, "participant: error while connecting to mongodb")
+	}
+	defer client.Disconnect(ctx)
+	// instance of the users collection on the mongodb for quill
+	users := client.Database(qs.cfg.Database).Collection(qs.cfg.UsersCollection)
+	filter := bson.D{}
+	if since > 0 && len(qs.cfg.Fields.UpdatedAt) > 0 {
+		// $gte since a user can be updated again in the same millisecond
+		// after the previous sync, upserting a user twice is harmless
+		filter = bson.D{{Key: qs.cfg.Fields.UpdatedAt, Value: bson.D{{Key: "$gte", Value: since}}}}
+	}
+	cur, err := users.Find(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while find participants from collection")
+	}
+	defer cur.Close(ctx)
+
+	recs := []*Record{}
+	for cur.Next(ctx) {
+		br, err := cur.DecodeBytes()
+		if err != nil {
+			return nil, errors.Wrap(err, "participant: error while decoding bytes from mongo")
+		}
+		recs = append(recs, qs.cfg.Fields.record(br))
+	}
+	return recs, nil
+}
+
+func (qs *quillSource) fetchResumes(ctx context.Context, recs []*Record) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	client, err := mongo.NewClient(qs.resumesURI)
+	if err != nil {
+		return err
+	}
+	log.Debug("connecting to resumes mongodb")
+	if err := client.Connect(ctx); err != nil {
+		return err
+	}
+	defer client.Disconnect(ctx)
+	resumes := client.Database(qs.cfg.ResumesDatabase).Collection(qs.cfg.ResumesCollection)
+	for _, r := range recs {
+		if len(r.Name) == 0 || len(r.ExternalID) == 0 {
+			continue
+		}
+		br, err := resumes.FindOne(ctx, bson.D{
+			{Key: qs.cfg.ResumeUserField, Value: r.ExternalID},
+		}).DecodeBytes()
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				continue
+			}
+			return err
+		}
+		r.Resume = lookupString(br, qs.cfg.ResumeURLField)
+	}
+	return nil
+}
+
+func validScheme(dbURI string) error {
+	url, err := url.Parse(dbURI)
+	if err != nil {
+		return errors.Wrap(err, "participant: error while parsing url for sheme validation")
+	}
+	if len(url.Scheme) > 0 && url.Scheme != "mongodb" {
+		return ErrUnsupportedScheme
+	}
+	return nil
+}
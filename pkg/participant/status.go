@@ -0,0 +1,104 @@
+package participant
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrSyncNotRunning is an error returned when a sync is triggered before
+// Sync has been started
+var ErrSyncNotRunning = errors.New("participant: sync is not running")
+
+// Status describes the most recent sync of the participants
+type Status struct {
+	Source string
+	// Running is true while a sync is in progress
+	Running bool
+	// Full is true when the last successful sync was a full sync
+	Full       bool
+	LastStart  time.Time
+	LastFinish time.Time
+	Duration   time.Duration
+	Saved      int
+	Deleted    int
+	// Mirrored is the number of resumes mirrored by the last sync
+	Mirrored int
+	// Failed has an error message for every participant and every resume
+	// that could not be synced by the last sync
+	Failed []string
+	// LastError is the error of the last sync, empty if it succeeded
+	LastError string
+}
+
+var (
+	statusMu sync.Mutex
+	status   Status
+	// trigger is used to run a sync immediately, the value tells whether the
+	// sync has to be a full sync
+	trigger chan bool
+)
+
+// CurrentStatus returns the status of the most recent sync
+func CurrentStatus() Status {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	s := status
+	s.Failed = append([]string(nil), status.Failed...)
+	return s
+}
+
+// Trigger runs a sync immediately instead of waiting for the next sync period.
+// Triggering a sync while another one is pending is a no-op
+func Trigger(full bool) error {
+	statusMu.Lock()
+	t := trigger
+	statusMu.Unlock()
+	if t == nil {
+		return ErrSyncNotRunning
+	}
+	select {
+	case t <- full:
+	default:
+	}
+	return nil
+}
+
+func startStatus(source string, start time.Time) {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	status.Source = source
+	status.Running = true
+	status.LastStart = start
+}
+
+// finishStatus records the outcome of a sync, the result is recorded even
+// when the sync failed after the participants were saved
+func finishStatus(res *Result, err error, finish time.Time) {
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	status.Running = false
+	status.LastFinish = finish
+	status.Duration = finish.Sub(status.LastStart)
+	status.LastError = ""
+	if err != nil {
+		status.LastError = err.Error()
+	}
+	if res == nil {
+		return
+	}
+	status.Full = res.Full
+	status.Saved = res.Saved
+	status.Deleted = res.Deleted
+	status.Mirrored = res.Mirrored
+	status.Failed = make([]string, 0, len(res.Failed)+len(res.MirrorFailed))
+	for _, f := range res.Failed {
+		status.Failed = append(status.Failed, f.Error())
+	}
+	for _, f := range res.MirrorFailed {
+		status.Failed = append(status.Failed,
+			fmt.Sprintf("resume of participant %s: %v", f.ParticipantID, f.Err))
+	}
+}
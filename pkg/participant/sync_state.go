@@ -0,0 +1,81 @@
+package participant
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// syncState is the progress of the sync from an external database that is
+// persisted so that a restart does not force a full sync
+type syncState struct {
+	Source string `db:"source"`
+	// Watermark is the latest update time (in milliseconds since the epoch)
+	// of all the participants that have been synced from the source
+	Watermark int64 `db:"watermark"`
+	// LastFullSync is the time of the last sync that fetched every
+	// participant and deleted the ones missing from the source
+	LastFullSync pq.NullTime `db:"last_full_sync"`
+}
+
+// loadSyncState fetches the sync state of a source, a source that has never
+// been synced has an empty state
+func loadSyncState(source string) (*syncState, error) {
+	query := `SELECT source, watermark, last_full_sync FROM sync_state WHERE source = $1`
+	st := new(syncState)
+	err := db.Conn.Get(st, query, source)
+	if err == sql.ErrNoRows {
+		return &syncState{Source: source}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "participant: error while loading sync state")
+	}
+	return st, nil
+}
+
+// needsFullSync returns true when there has not been a full sync for the
+// duration every
+func (st *syncState) needsFullSync(every time.Duration, now time.Time) bool {
+	if !st.LastFullSync.Valid {
+		return true
+	}
+	return now.Sub(st.LastFullSync.Time) >= every
+}
+
+// advance moves the watermark to the latest update of the synced participants.
+// The watermark is kept at the oldest participant that failed so that the
+// failed participants are fetched again by the next sync
+func (st *syncState) advance(recs []*Record, failed []*RecordError, full bool, now time.Time) {
+	for _, r := range recs {
+		if r.UpdatedAt > st.Watermark {
+			st.Watermark = r.UpdatedAt
+		}
+	}
+	for _, f := range failed {
+		if f.updatedAt < st.Watermark {
+			st.Watermark = f.updatedAt
+		}
+	}
+	if full {
+		st.LastFullSync = pq.NullTime{Time: now, Valid: true}
+	}
+}
+
+// save persists the sync state within the transaction of a sync so that the
+// watermark only moves when the participants were saved
+func (st *syncState) save(tx *sqlx.Tx) error {
+	query := `
+	INSERT INTO sync_state(source, watermark, last_full_sync)
+	VALUES(:source, :watermark, :last_full_sync)
+	ON CONFLICT (source) DO UPDATE
+	SET watermark = EXCLUDED.watermark, last_full_sync = EXCLUDED.last_full_sync,
+	updated_at = NOW()`
+	if _, err := tx.NamedExec(query, st); err != nil {
+		return errors.Wrap(err, "participant: error while saving sync state")
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package participant
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdvanceStopsAtFailedRecords(t *testing.T) {
+	recs := []*Record{
+		{ExternalID: "a", GradYear: "2020", UpdatedAt: 100},
+		{ExternalID: "b", GradYear: "spring", UpdatedAt: 200},
+		{ExternalID: "c", GradYear: "2021", UpdatedAt: 300},
+	}
+	_, failed := records(recs)
+	st := &syncState{Watermark: 50}
+	st.advance(recs, failed, false, time.Now())
+	if st.Watermark != 200 {
+		t.Fatalf("expected the watermark at the failed record got: %d", st.Watermark)
+	}
+	st.advance(recs, nil, false, time.Now())
+	if st.Watermark != 300 {
+		t.Fatalf("expected the watermark at the latest record got: %d", st.Watermark)
+	}
+}
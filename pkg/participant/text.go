@@ -0,0 +1,58 @@
+package participant
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ledongthuc/pdf"
+	"github.com/pkg/errors"
+)
+
+// maxResumeText is the largest amount of text that is kept from a resume, a
+// resume with more text than this is most likely not a resume
+const maxResumeText = 64 * 1024
+
+// extractText extracts the plain text from a resume so that it can be
+// searched, the text of resumes in a format other than PDF or plain text
+// is empty
+func extractText(bb []byte, contentType string) (text string, err error) {
+	var r io.Reader
+	switch contentType {
+	case "application/pdf":
+		// the pdf reader panics on some malformed PDFs
+		defer func() {
+			if p := recover(); p != nil {
+				text, err = "", errors.Errorf("participant: malformed pdf: %v", p)
+			}
+		}()
+		pr, err := pdf.NewReader(bytes.NewReader(bb), int64(len(bb)))
+		if err != nil {
+			return "", errors.Wrap(err, "participant: error while reading pdf")
+		}
+		r, err = pr.GetPlainText()
+		if err != nil {
+			return "", errors.Wrap(err, "participant: error while extracting text from pdf")
+		}
+	case "text/plain":
+		r = bytes.NewReader(bb)
+	default:
+		return "", nil
+	}
+	tb, err := ioutil.ReadAll(io.LimitReader(r, maxResumeText))
+	if err != nil {
+		return "", errors.Wrap(err, "participant: error while extracting text")
+	}
+	return cleanText(tb), nil
+}
+
+// cleanText makes text safe to store in postgres which does not allow NUL
+// characters or invalid UTF-8 and collapses all the whitespace
+func cleanText(tb []byte) string {
+	if !utf8.Valid(tb) {
+		tb = bytes.ToValidUTF8(tb, nil)
+	}
+	return strings.Join(strings.Fields(strings.Replace(string(tb), "\x00", " ", -1)), " ")
+}
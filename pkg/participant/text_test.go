@@ -0,0 +1,26 @@
+package participant
+
+import "testing"
+
+func TestExtractPlainText(t *testing.T) {
+	text, err := extractText([]byte("Rust\x00 and\n\tGo \xff internship"), "text/plain")
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if text != "Rust and Go internship" {
+		t.Fatalf("unexpected text: %q", text)
+	}
+}
+
+func TestExtractMalformedPDF(t *testing.T) {
+	if _, err := extractText([]byte("%PDF-1.4 garbage"), "application/pdf"); err == nil {
+		t.Fatal("expected an error for a malformed pdf")
+	}
+}
+
+func TestExtractUnsupported(t *testing.T) {
+	text, err := extractText([]byte("binary"), "application/msword")
+	if err != nil || text != "" {
+		t.Fatalf("expected no text got %q: %v", text, err)
+	}
+}
@@ -0,0 +1,69 @@
+package participant
+
+import (
+	"context"
+
+	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ViaRPC is used for the resumes that were returned by an RPC call
+	ViaRPC = "rpc"
+	// ViaSignedURL is used for the resumes that were downloaded from a
+	// signed URL
+	ViaSignedURL = "signed_url"
+)
+
+var (
+	// ErrNotFound is returned when there is no participant with the given ID
+	ErrNotFound = errors.New("participant: participant not found")
+	// ErrNoResume is returned when a participant did not upload a resume
+	ErrNoResume = errors.New("participant: participant has no resume")
+)
+
+// ByID returns the participant with the given ID
+func ByID(id string) (*Participant, error) {
+	rows, err := db.Conn.Queryx(`SELECT `+selectColumns+` FROM participants
+	WHERE id::text = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	pSlice, err := scanParticipants(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(pSlice) == 0 {
+		return nil, ErrNotFound
+	}
+	return &pSlice[0], nil
+}
+
+// ResumeFile returns the resume of a participant along with its content type.
+// The mirrored copy of the resume is used when there is one
+func ResumeFile(ctx context.Context, p *Participant) ([]byte, string, error) {
+	if len(p.Resume) == 0 {
+		return nil, "", ErrNoResume
+	}
+	if bb, ok := cachedResume(ctx, *p); ok {
+		return bb, p.ResumeContentType, nil
+	}
+	return DefaultDownloader.get(ctx, p.Resume)
+}
+
+// RecordView records that the resume of a participant was viewed by the
+// admin or sponsor with the given ID
+func RecordView(participantID, viewerID, via string) error {
+	_, err := db.Conn.NamedExec(`
+	INSERT INTO resume_views (participant_id, viewer_id, via)
+	VALUES (:participant_id, :viewer_id, :via)`, map[string]interface{}{
+		"participant_id": participantID,
+		"viewer_id":      viewerID,
+		"via":            via,
+	})
+	if err != nil {
+		return errors.Wrap(err, "participant: error while recording resume view")
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/auburnhacks/sponsor/pkg/admin"
+	"github.com/auburnhacks/sponsor/pkg/auth"
+	"github.com/auburnhacks/sponsor/pkg/log"
+	"github.com/auburnhacks/sponsor/pkg/mail"
+	"github.com/auburnhacks/sponsor/pkg/session"
+	"github.com/auburnhacks/sponsor/pkg/sponsor"
+	api "github.com/auburnhacks/sponsor/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// minPasswordLength is the minimum length of a password that a user sets
+const minPasswordLength = 8
+
+// errWeakPassword is returned when a user sets a password that is too short
+var errWeakPassword = errors.Errorf("server: password must be at least %d characters", minPasswordLength)
+
+// appLink returns the link to a page of the web app with the token
+func appLink(page, token string) string {
+	return fmt.Sprintf("%s/%s?token=%s", *AppURL, page, url.QueryEscape(token))
+}
+
+// sendInvite emails an invite to a sponsor that was created without a
+// password
+func sendInvite(ctx context.Context, sp *sponsor.Sponsor, c *sponsor.Company) error {
+	token, err := session.IssueTicket(session.PurposeInvite, auth.KindSponsor, sp.ID,
+		session.InviteTTL)
+	if err != nil {
+		return err
+	}
+	return mail.Send(ctx, mail.Message{
+		To:      sp.Email,
+		Subject: "You have been invited to the AuburnHacks sponsor portal",
+		Body: fmt.Sprintf("Hi %s,\n\nYou have been invited to the AuburnHacks sponsor "+
+			"portal as a sponsor of %s. Set your password within %s to get started:\n\n%s\n",
+			sp.Name, c.Name, session.InviteTTL, appLink("invite", token)),
+	})
+}
+
+// sendPasswordReset emails a password reset token to a user, nothing is sent
+// when a reset was already sent to the user within session.ResetInterval
+func sendPasswordReset(ctx context.Context, kind auth.Kind, id, name, email string) error {
+	recent, err := session.IssuedWithin(session.PurposeReset, id, session.ResetInterval)
+	if err != nil {
+		return err
+	}
+	if recent {
+		log.GetLogger(ctx).Infof("password reset already sent to %s recently", id)
+		return nil
+	}
+	token, err := session.IssueTicket(session.PurposeReset, kind, id, session.ResetTTL)
+	if err != nil {
+		return err
+	}
+	return mail.Send(ctx, mail.Message{
+		To:      email,
+		Subject: "Reset your AuburnHacks sponsor portal password",
+		Body: fmt.Sprintf("Hi %s,\n\nSomebody asked to reset your password. Use the "+
+			"link below within %s to pick a new one, you can ignore this email if it "+
+			"was not you:\n\n%s\n", name, session.ResetTTL, appLink("reset-password", token)),
+	})
+}
+
+// redeemForPassword redeems a ticket and sets the new password of its user
+// in the same transaction, so the ticket stays valid if the password can not
+// be saved. All the sessions of the user are revoked once it is saved
+func redeemForPassword(purpose session.Purpose, token, password string) error {
+	t, err := session.RedeemTicket(purpose, token, func(tx *sqlx.Tx, t *session.Ticket) error {
+		switch t.Kind {
+		case auth.KindAdmin:
+			return admin.UpdatePassword(tx, t.SubjectID, password)
+		case auth.KindSponsor:
+			return sponsor.UpdatePassword(tx, t.SubjectID, password)
+		}
+		return session.ErrInvalidTicket
+	})
+	if err != nil {
+		return err
+	}
+	return session.RevokeSubject(t.SubjectID)
+}
+
+// AcceptInvite is a method on the rpcServer that sets the password of an
+// invited sponsor, the invite can only be used once
+func (ss *rpcServer) AcceptInvite(ctx context.Context,
+	req *api.AcceptInviteRequest) (*api.AcceptInviteResponse, error) {
+	if len(req.Password) < minPasswordLength {
+		return nil, errWeakPassword
+	}
+	if err := redeemForPassword(session.PurposeInvite, req.Token, req.Password); err != nil {
+		return nil, err
+	}
+	return &api.AcceptInviteResponse{
+		Ok: true,
+	}, nil
+}
+
+// RequestPasswordReset is a method on the rpcServer that emails a password
+// reset token to the admin or sponsor with the email. It always succeeds and
+// the email is sent in the background so that neither the response nor its
+// timing can be used to find out who has an account
+func (ss *rpcServer) RequestPasswordReset(ctx context.Context,
+	req *api.RequestPasswordResetRequest) (*api.RequestPasswordResetResponse, error) {
+	// the context of the request is canceled once the response is sent
+	bgCtx := log.WithFields(context.Background(), log.GetLogger(ctx).Data)
+	go requestPasswordReset(bgCtx, req.Email)
+	return &api.RequestPasswordResetResponse{
+		Ok: true,
+	}, nil
+}
+
+// requestPasswordReset emails a password reset token to the admin or sponsor
+// with the email if there is one
+func requestPasswordReset(ctx context.Context, email string) {
+	logger := log.GetLogger(ctx)
+	if a, err := admin.ByEmail(email); err == nil {
+		if err := sendPasswordReset(ctx, auth.KindAdmin, a.ID, a.Name, a.Email); err != nil {
+			logger.Errorf("error while sending password reset: %v", err)
+		}
+	}
+	if sp, err := sponsor.ByEmail(email); err == nil {
+		if err := sendPasswordReset(ctx, auth.KindSponsor, sp.ID, sp.Name, sp.Email); err != nil {
+			logger.Errorf("error while sending password reset: %v", err)
+		}
+	}
+}
+
+// ResetPassword is a method on the rpcServer that sets a new password using a
+// password reset token, every session of the user is revoked
+func (ss *rpcServer) ResetPassword(ctx context.Context,
+	req *api.ResetPasswordRequest) (*api.ResetPasswordResponse, error) {
+	if len(req.Password) < minPasswordLength {
+		return nil, errWeakPassword
+	}
+	if err := redeemForPassword(session.PurposeReset, req.Token, req.Password); err != nil {
+		return nil, err
+	}
+	return &api.ResetPasswordResponse{
+		Ok: true,
+	}, nil
+}
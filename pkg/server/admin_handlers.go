@@ -7,12 +7,47 @@ import (
 	"github.com/auburnhacks/sponsor/pkg/admin"
 	"github.com/auburnhacks/sponsor/pkg/auth"
 	"github.com/auburnhacks/sponsor/pkg/log"
+	"github.com/auburnhacks/sponsor/pkg/session"
 	api "github.com/auburnhacks/sponsor/proto"
-	"github.com/dgrijalva/jwt-go"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// errNotAdmin is returned by RPC calls that can only be made by an admin
+var errNotAdmin = errors.New("server: only admins are allowed to make this call")
+
+// adminFromContext returns the admin that is making the request
+func adminFromContext(ctx context.Context) (*admin.Admin, error) {
+	p, err := auth.PrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !p.IsAdmin() {
+		return nil, errNotAdmin
+	}
+	a, err := admin.ByID(p.SubjectID)
+	if err != nil {
+		return nil, errNotAdmin
+	}
+	return a, nil
+}
+
+// errMissingField is returned when a field that a request must have is missing,
+// it is an invalid argument so that the gateway answers with a 400
+func errMissingField(name string) error {
+	return status.Errorf(codes.InvalidArgument, "server: %s is required", name)
+}
+
+// hasPermission reports whether the roles of the user making the request
+// grant the permission
+func hasPermission(ctx context.Context, perm auth.Permission) bool {
+	p, err := auth.PrincipalFromContext(ctx)
+	return err == nil && p.Can(perm)
+}
+
 // CreateAdmin is a method on the rpcServer that is used to create an admin and save it to the database
+// NOTE: the first admin is created without authentication and is a super admin
 func (s *rpcServer) CreateAdmin(ctx context.Context, req *api.CreateAdminRequest) (*api.CreateAdminResponse, error) {
 	logger := log.GetLogger(ctx)
 	a, _ := admin.ByEmail(req.Email)
@@ -20,7 +55,14 @@ func (s *rpcServer) CreateAdmin(ctx context.Context, req *api.CreateAdminRequest
 		return nil, fmt.Errorf("email %s already exists", req.Email)
 	}
 	a = admin.New(req.Name, req.Email, req.PasswordPlainText)
-	if err := a.Register(); err != nil {
+	register := a.Register
+	if _, err := auth.PrincipalFromContext(ctx); err != nil {
+		// the request was not authenticated so it can only create the first
+		// admin, which is a super admin
+		a.ACL = string(auth.RoleSuperAdmin)
+		register = a.RegisterFirst
+	}
+	if err := register(); err != nil {
 		logger.Errorf("error while registering admin: %v", err)
 		return nil, err
 	}
@@ -36,7 +78,15 @@ func (s *rpcServer) CreateAdmin(ctx context.Context, req *api.CreateAdminRequest
 }
 
 // GetAdmin is a method on the rpcServer that is used to get information of an admin
+// NOTE: an admin can always get their own information
 func (s *rpcServer) GetAdmin(ctx context.Context, req *api.GetAdminRequest) (*api.GetAdminResponse, error) {
+	a, err := adminFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if a.ID != req.AdminId && !hasPermission(ctx, auth.PermAdminsRead) {
+		return nil, auth.ErrUnauthorized
+	}
 	admin, err := admin.ByID(req.AdminId)
 	if err != nil {
 		return nil, err
@@ -52,8 +102,25 @@ func (s *rpcServer) GetAdmin(ctx context.Context, req *api.GetAdminRequest) (*ap
 }
 
 // DeleteAdmin is a method on the rpcServer that is used to delete an admin from the database
+// NOTE: the last admin can never be deleted
 func (s *rpcServer) DeleteAdmin(ctx context.Context, req *api.DeleteAdminRequest) (*api.DeleteAdminResponse, error) {
-	return &api.DeleteAdminResponse{}, nil
+	if _, err := adminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	a, err := admin.ByID(req.AdminId)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.Delete(); err != nil {
+		return nil, err
+	}
+	// the tokens of the admin must stop working right away
+	if err := session.RevokeSubject(a.ID); err != nil {
+		return nil, err
+	}
+	return &api.DeleteAdminResponse{
+		Ok: true,
+	}, nil
 }
 
 // LoginAdmin is a methodd on te rpcServer that is used to sign in an admin
@@ -64,14 +131,15 @@ func (s *rpcServer) LoginAdmin(ctx context.Context, req *api.LoginAdminRequest)
 	if err != nil {
 		return nil, err
 	}
-	cl := auth.New(admin.ID, admin.ACL)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, cl)
-	tokenStr, err := token.SignedString(s.privKey)
+	cl := auth.NewAdmin(admin.ID, admin.ACL)
+	tokenStr, refresh, err := s.startSession(cl)
 	if err != nil {
 		return nil, err
 	}
 	return &api.LoginAdminResponse{
-		Token: tokenStr,
+		Token:        tokenStr,
+		RefreshToken: refresh,
+		ExpiresAt:    cl.Expiry().Unix(),
 		Admin: &api.Admin{
 			Id:    admin.ID,
 			Name:  admin.Name,
@@ -86,17 +154,32 @@ func (s *rpcServer) LoginAdmin(ctx context.Context, req *api.LoginAdminRequest)
 func (s *rpcServer) UpdateAdmin(ctx context.Context, req *api.UpdateAdminRequest) (*api.UpdateAdminResponse, error) {
 	logger := log.GetLogger(ctx)
 	logger.Debugf("%+v", req)
+	if req.Admin == nil {
+		return nil, errMissingField("admin")
+	}
 	admin, err := admin.ByID(req.AdminId)
 	if err != nil {
 		return nil, err
 	}
+	aclChanged := admin.ACL != req.Admin.ACL
 	// Update all the fields
 	admin.Name = req.Admin.Name
 	admin.Email = req.Admin.Email
 	admin.ACL = req.Admin.ACL
+	if len(req.Admin.Password) > 0 {
+		if err := admin.SetPassword(req.Admin.Password); err != nil {
+			return nil, err
+		}
+	}
 	if err := admin.Save(); err != nil {
 		return nil, errors.Wrap(err, "error while saving admin to db")
 	}
+	// a new password or new roles log the admin out of every session
+	if len(req.Admin.Password) > 0 || aclChanged {
+		if err := session.RevokeSubject(admin.ID); err != nil {
+			return nil, err
+		}
+	}
 	return &api.UpdateAdminResponse{
 		Admin: &api.Admin{
 			Id:    admin.ID,
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+
+	"github.com/auburnhacks/sponsor/pkg/auth"
+	"github.com/auburnhacks/sponsor/pkg/pagination"
+	"github.com/auburnhacks/sponsor/pkg/sponsor"
+	api "github.com/auburnhacks/sponsor/proto"
+)
+
+// authorizeCompany returns an error when the user making the request is a
+// sponsor of another company, admins are allowed to manage every company
+func authorizeCompany(ctx context.Context, companyID string) error {
+	p, err := auth.PrincipalFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if p.IsSponsor() && p.CompanyID != companyID {
+		return auth.ErrUnauthorized
+	}
+	return nil
+}
+
+// GetCompany is a method on the rpcServer that returns a single company
+func (ss *rpcServer) GetCompany(ctx context.Context,
+	req *api.GetCompanyRequest) (*api.GetCompanyResponse, error) {
+	c, err := sponsor.CompanyByID(req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetCompanyResponse{
+		Company: apiCompany(c),
+	}, nil
+}
+
+// UpdateCompany is a method on the rpcServer that changes the name and the
+// logo of a company
+func (ss *rpcServer) UpdateCompany(ctx context.Context,
+	req *api.UpdateCompanyRequest) (*api.UpdateCompanyResponse, error) {
+	if err := authorizeCompany(ctx, req.CompanyId); err != nil {
+		return nil, err
+	}
+	c, err := sponsor.CompanyByID(req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Company != nil {
+		c.Name = req.Company.Name
+		c.Logo = req.Company.Logo
+	}
+	if err := c.Update(); err != nil {
+		return nil, err
+	}
+	return &api.UpdateCompanyResponse{
+		Company: apiCompany(c),
+	}, nil
+}
+
+// ListCompanySponsors is a method on the rpcServer that lists a page of the
+// sponsors of a company
+func (ss *rpcServer) ListCompanySponsors(ctx context.Context,
+	req *api.ListCompanySponsorsRequest) (*api.ListCompanySponsorsResponse, error) {
+	if err := authorizeCompany(ctx, req.CompanyId); err != nil {
+		return nil, err
+	}
+	c, err := sponsor.CompanyByID(req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+	pg, err := pagination.New(req.PageToken, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	sponsors, next, err := sponsor.ListSponsors(c.ID, pg)
+	if err != nil {
+		return nil, err
+	}
+	apiSponsors := make([]*api.Sponsor, len(sponsors))
+	for i, s := range sponsors {
+		apiSponsors[i] = &api.Sponsor{
+			Id:      s.ID,
+			Name:    s.Name,
+			Email:   s.Email,
+			Company: apiCompany(c),
+			ACL:     s.ACL,
+		}
+	}
+	return &api.ListCompanySponsorsResponse{
+		Sponsors:      apiSponsors,
+		NextPageToken: next,
+	}, nil
+}
+
+// SetCompanyTier is a method on the rpcServer that changes the sponsorship
+// tier of a company, it can only be called by an admin
+func (ss *rpcServer) SetCompanyTier(ctx context.Context,
+	req *api.SetCompanyTierRequest) (*api.SetCompanyTierResponse, error) {
+	if _, err := adminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	c, err := sponsor.CompanyByID(req.CompanyId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.SetTier(req.Tier); err != nil {
+		return nil, err
+	}
+	return &api.SetCompanyTierResponse{
+		Company: apiCompany(c),
+	}, nil
+}
+
+// apiCompany converts a company to its protobuf representation
+func apiCompany(c *sponsor.Company) *api.Company {
+	return &api.Company{
+		Id:   c.ID,
+		Name: c.Name,
+		Logo: c.Logo,
+		Tier: c.Tier,
+	}
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/auburnhacks/sponsor/pkg/admin"
+	"github.com/auburnhacks/sponsor/pkg/auth"
+	"github.com/auburnhacks/sponsor/pkg/log"
+	"github.com/auburnhacks/sponsor/pkg/pagination"
+	"github.com/auburnhacks/sponsor/pkg/participant"
+	"github.com/auburnhacks/sponsor/pkg/sponsor"
+	"github.com/pkg/errors"
+)
+
+// errNoMatchingResumes is returned when a sponsor with a resume limit asks for
+// an archive of resumes that matches no resume
+var errNoMatchingResumes = errors.New("server: no resumes match the request")
+
+// principal returns the admin or the sponsor making the request, exactly one
+// of them is not nil when there is no error
+func principal(ctx context.Context) (*admin.Admin, *sponsor.Sponsor, error) {
+	p, err := auth.PrincipalFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p.IsAdmin() {
+		a, err := adminFromContext(ctx)
+		return a, nil, err
+	}
+	sp, err := sponsorFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, sp, nil
+}
+
+// sponsorCompany returns the sponsor making the request and its company, the
+// sponsor is nil when an admin is making the request. Admins are entitled to
+// everything so the entitlements only have to be checked for a sponsor
+func sponsorCompany(ctx context.Context) (*sponsor.Sponsor, *sponsor.Company, error) {
+	_, sp, err := principal(ctx)
+	if err != nil || sp == nil {
+		return nil, nil, err
+	}
+	c, err := sponsor.CompanyByID(sp.CompanyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sp, c, nil
+}
+
+// canViewParticipants returns an error when the user making the request is
+// not entitled to see the participants
+func canViewParticipants(ctx context.Context) error {
+	sp, c, err := sponsorCompany(ctx)
+	if err != nil || sp == nil {
+		return err
+	}
+	return c.Entitlements().CanViewParticipants(time.Now())
+}
+
+// reservation is the resumes that a request reserved for the company of a
+// sponsor that had not been downloaded before. The ones that end up not being
+// sent are released so that they do not count towards the limit of the tier
+type reservation struct {
+	company *sponsor.Company
+	ids     []string
+}
+
+// release gives back the reserved resumes of the participants, it does
+// nothing for a nil reservation. The error is only logged since the request
+// has already failed or succeeded by then
+func (r *reservation) release(ctx context.Context, participantIDs []string) {
+	if r == nil || len(participantIDs) == 0 {
+		return
+	}
+	reserved := map[string]bool{}
+	for _, id := range r.ids {
+		reserved[id] = true
+	}
+	ids := []string{}
+	for _, id := range participantIDs {
+		if reserved[id] {
+			ids = append(ids, id)
+		}
+	}
+	if err := r.company.ReleaseResumes(ids); err != nil {
+		log.GetLogger(ctx).Errorf("error while releasing resumes: %v", err)
+	}
+}
+
+// releaseAll gives back every reserved resume
+func (r *reservation) releaseAll(ctx context.Context) {
+	if r != nil {
+		r.release(ctx, r.ids)
+	}
+}
+
+// releaseFailed gives back the reserved resumes that could not be downloaded
+func (r *reservation) releaseFailed(ctx context.Context, failed []participant.Failure) {
+	ids := make([]string, len(failed))
+	for i, f := range failed {
+		ids[i] = f.ParticipantID
+	}
+	r.release(ctx, ids)
+}
+
+// reserveResumes returns an error when the user making the request is not
+// entitled to the resumes of the participants that match the filter. The
+// resumes count towards the limit of the tier of a sponsor as soon as they are
+// reserved so the filter is narrowed down to the reserved participants. The
+// reservation is nil when the resumes are not limited
+func reserveResumes(ctx context.Context, f participant.Filter) (participant.Filter, *reservation, error) {
+	sp, c, err := sponsorCompany(ctx)
+	if err != nil || sp == nil {
+		return f, nil, err
+	}
+	if err := c.Entitlements().CanViewResumes(time.Now()); err != nil {
+		return f, nil, err
+	}
+	if c.Entitlements().MaxResumes == 0 {
+		return f, nil, nil
+	}
+	f.HasResume = true
+	participants, _, err := participant.List(f, pagination.Page{})
+	if err != nil {
+		return f, nil, err
+	}
+	if len(participants) == 0 {
+		return f, nil, errNoMatchingResumes
+	}
+	f.IDs = make([]string, len(participants))
+	for i, p := range participants {
+		f.IDs[i] = p.ID
+	}
+	ids, err := c.ReserveResumes(f.IDs)
+	if err != nil {
+		return f, nil, err
+	}
+	return f, &reservation{company: c, ids: ids}, nil
+}
+
+// notesSponsor returns the sponsor making the request when the sponsor is
+// entitled to notes
+func notesSponsor(ctx context.Context) (*sponsor.Sponsor, error) {
+	sp, err := sponsorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c, err := sponsor.CompanyByID(sp.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Entitlements().CanTakeNotes(); err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+
+	"github.com/auburnhacks/sponsor/pkg/pagination"
+	"github.com/auburnhacks/sponsor/pkg/sponsor"
+	api "github.com/auburnhacks/sponsor/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// CreateNote is a method on the rpcServer that attaches a private note to a
+// participant. The note is only visible to the company of the author
+func (ss *rpcServer) CreateNote(ctx context.Context,
+	req *api.CreateNoteRequest) (*api.CreateNoteResponse, error) {
+	sp, err := notesSponsor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n := sp.NewNote(req.ParticipantId, req.Body, rating(req.Rating))
+	if err := n.Create(); err != nil {
+		return nil, err
+	}
+	return &api.CreateNoteResponse{
+		Note: apiNote(n),
+	}, nil
+}
+
+// ListNotes is a method on the rpcServer that lists all the notes the sponsors
+// of a company have written about a participant
+func (ss *rpcServer) ListNotes(ctx context.Context,
+	req *api.ListNotesRequest) (*api.ListNotesResponse, error) {
+	sp, err := notesSponsor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pg, err := pagination.New(req.PageToken, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	notes, next, err := sponsor.ListNotes(sp.CompanyID, req.ParticipantId, pg)
+	if err != nil {
+		return nil, err
+	}
+	apiNotes := make([]*api.Note, len(notes))
+	for i, n := range notes {
+		apiNotes[i] = apiNote(n)
+	}
+	return &api.ListNotesResponse{
+		Notes:         apiNotes,
+		NextPageToken: next,
+	}, nil
+}
+
+// UpdateNote is a method on the rpcServer that modifies a note, only the
+// author of a note is allowed to modify it
+func (ss *rpcServer) UpdateNote(ctx context.Context,
+	req *api.UpdateNoteRequest) (*api.UpdateNoteResponse, error) {
+	sp, err := notesSponsor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := sponsor.NoteByID(req.NoteId, sp.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.CanModify(sp); err != nil {
+		return nil, err
+	}
+	n.Body = req.Body
+	n.Rating = rating(req.Rating)
+	if err := n.Save(); err != nil {
+		return nil, err
+	}
+	return &api.UpdateNoteResponse{
+		Note: apiNote(n),
+	}, nil
+}
+
+// DeleteNote is a method on the rpcServer that deletes a note, only the
+// author of a note is allowed to delete it
+func (ss *rpcServer) DeleteNote(ctx context.Context,
+	req *api.DeleteNoteRequest) (*api.DeleteNoteResponse, error) {
+	sp, err := notesSponsor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := sponsor.NoteByID(req.NoteId, sp.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.CanModify(sp); err != nil {
+		return nil, err
+	}
+	if err := n.Delete(); err != nil {
+		return nil, err
+	}
+	return &api.DeleteNoteResponse{
+		Ok: true,
+	}, nil
+}
+
+// apiNote converts a note to its protobuf representation
+func apiNote(n *sponsor.Note) *api.Note {
+	return &api.Note{
+		Id:            n.ID,
+		ParticipantId: n.ParticipantID,
+		SponsorId:     n.SponsorID,
+		Body:          n.Body,
+		Rating:        apiRating(n.Rating),
+		CreatedAt:     n.CreatedAt.Unix(),
+		UpdatedAt:     n.UpdatedAt.Unix(),
+	}
+}
+
+// rating converts the optional rating of a request, nil means no rating
+func rating(r *wrappers.Int32Value) *int {
+	if r == nil {
+		return nil
+	}
+	v := int(r.Value)
+	return &v
+}
+
+// apiRating converts the rating of a note to its protobuf representation
+func apiRating(r *int) *wrappers.Int32Value {
+	if r == nil {
+		return nil
+	}
+	return &wrappers.Int32Value{Value: int32(*r)}
+}
@@ -3,57 +3,125 @@ package server
 import (
 	"context"
 
+	"github.com/auburnhacks/sponsor/pkg/pagination"
 	"github.com/auburnhacks/sponsor/pkg/participant"
 	"github.com/auburnhacks/sponsor/pkg/sponsor"
 	api "github.com/auburnhacks/sponsor/proto"
 )
 
 // ListParticipants is a method on the SponsorServer that lists all the participants
-// are synced from the external database
+// are synced from the external database. The participants can be narrowed down
+// using the filters in the request
 func (s *rpcServer) ListParticipants(ctx context.Context,
 	req *api.ListParticipantsRequest) (*api.ListParticipantsResponse, error) {
-	pSlice, err := participant.List()
+	if err := canViewParticipants(ctx); err != nil {
+		return nil, err
+	}
+	f := participant.Filter{
+		University:  req.University,
+		Major:       req.Major,
+		GradYearMin: int(req.GradYearMin),
+		GradYearMax: int(req.GradYearMax),
+		HasResume:   req.HasResume,
+		HasGithub:   req.HasGithub,
+		Query:       req.Query,
+	}
+	if req.Shortlisted {
+		sp, err := sponsorFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		f.ShortlistedBy = sp.CompanyID
+	}
+	pg, err := pagination.New(req.PageToken, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	pSlice, next, err := participant.List(f, pg)
 	if err != nil {
 		return nil, err
 	}
 	// prp = protobufParticipant
 	prp := make([]*api.Participant, len(pSlice))
-	i := 0
-	for _, p := range pSlice {
-		prp[i] = &api.Participant{
-			Id:         p.ID,
-			Name:       p.Name,
-			Email:      p.Email,
-			University: p.University,
-			Major:      p.Major,
-			GradYear:   int32(p.GradYear),
-			Github:     p.Github,
-			Linkedin:   p.Linkedin,
-			Resume:     p.Resume,
-		}
-		i++
+	for i, p := range pSlice {
+		prp[i] = apiParticipant(p)
 	}
 	return &api.ListParticipantsResponse{
-		Participants: prp,
+		Participants:  prp,
+		NextPageToken: next,
+	}, nil
+}
+
+// SearchParticipants is a method on the SponsorServer that ranks the participants
+// by how well they match the keywords in the request
+func (s *rpcServer) SearchParticipants(ctx context.Context,
+	req *api.SearchParticipantsRequest) (*api.SearchParticipantsResponse, error) {
+	if err := canViewParticipants(ctx); err != nil {
+		return nil, err
+	}
+	f := participant.Filter{
+		University:  req.University,
+		Major:       req.Major,
+		GradYearMin: int(req.GradYearMin),
+		GradYearMax: int(req.GradYearMax),
+	}
+	if req.Shortlisted {
+		sp, err := sponsorFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		f.ShortlistedBy = sp.CompanyID
+	}
+	results, err := participant.Search(req.Query, f, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	apiResults := make([]*api.SearchResult, len(results))
+	for i, r := range results {
+		apiResults[i] = &api.SearchResult{
+			Participant: apiParticipant(r.Participant),
+			Rank:        r.Rank,
+			Snippet:     r.Snippet,
+		}
+	}
+	return &api.SearchParticipantsResponse{
+		Results: apiResults,
 	}, nil
 }
 
 func (s *rpcServer) ListCompanies(ctx context.Context,
 	req *api.ListCompaniesRequest) (*api.ListCompaniesResponse, error) {
-	companies, err := sponsor.ListCompanies()
+	pg, err := pagination.New(req.PageToken, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	companies, next, err := sponsor.ListCompanies(pg)
 	if err != nil {
 		return nil, err
 	}
 	var apiCompanies []*api.Company
 	for _, c := range companies {
-		apiC := &api.Company{
-			Id:   c.ID,
-			Name: c.Name,
-			Logo: c.Logo,
-		}
-		apiCompanies = append(apiCompanies, apiC)
+		apiCompanies = append(apiCompanies, apiCompany(c))
 	}
 	return &api.ListCompaniesResponse{
-		Companies: apiCompanies,
+		Companies:     apiCompanies,
+		NextPageToken: next,
 	}, nil
 }
+
+// apiParticipant converts a participant to its protobuf representation. The
+// url of the resume is never exposed since it is a permanent public link,
+// the resume can only be viewed through GetResume
+func apiParticipant(p participant.Participant) *api.Participant {
+	return &api.Participant{
+		Id:         p.ID,
+		Name:       p.Name,
+		Email:      p.Email,
+		University: p.University,
+		Major:      p.Major,
+		GradYear:   int32(p.GradYear),
+		Github:     p.Github,
+		Linkedin:   p.Linkedin,
+		HasResume:  len(p.Resume) > 0,
+	}
+}
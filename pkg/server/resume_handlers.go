@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/auburnhacks/sponsor/pkg/auth"
+	"github.com/auburnhacks/sponsor/pkg/log"
+	"github.com/auburnhacks/sponsor/pkg/participant"
+	api "github.com/auburnhacks/sponsor/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// signedResumePath is the path on the gateway that serves the signed urls
+// returned by GetResume, it is followed by the ID of the participant
+const signedResumePath = "/v1/sponsor/resumes/"
+
+// signedURLTTL is how long a signed url returned by GetResume is valid
+const signedURLTTL = 5 * time.Minute
+
+// chunkSize is the size of the chunks of an archive sent by StreamResumes,
+// it is well below the default 4MB message limit of gRPC
+const chunkSize = 64 * 1024
+
+// StreamResumes is a method on the rpcServer that streams an archive of the
+// resumes in chunks while the resumes are being downloaded
+func (ss *rpcServer) StreamResumes(req *api.ResumesRequest,
+	stream api.SponsorService_StreamResumesServer) error {
+	logger := log.GetLogger(stream.Context())
+	f, res, err := resumesFilter(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	cw := &chunkWriter{stream: stream, buf: make([]byte, 0, chunkSize)}
+	failed, err := participant.WriteResumes(stream.Context(), cw, req.Format, f)
+	if err == nil {
+		err = cw.flush()
+	}
+	if err != nil {
+		// the archive is useless when the stream was aborted
+		res.releaseAll(stream.Context())
+		logger.Errorf("error while streaming resumes: %v", err)
+		return err
+	}
+	if len(failed) > 0 {
+		res.releaseFailed(stream.Context(), failed)
+		logger.Warnf("%d resumes could not be downloaded", len(failed))
+	}
+	return nil
+}
+
+// resumesFilter builds the filter for the participants whose resumes are
+// requested, the shortlist can only be used by a sponsor. The resumes are
+// reserved for the sponsor making the request
+func resumesFilter(ctx context.Context, req *api.ResumesRequest) (participant.Filter, *reservation, error) {
+	f := participant.Filter{
+		IDs:         req.ParticipantIds,
+		University:  req.University,
+		Major:       req.Major,
+		GradYearMin: int(req.GradYearMin),
+		GradYearMax: int(req.GradYearMax),
+		Query:       req.Query,
+	}
+	if req.Shortlisted {
+		sp, err := sponsorFromContext(ctx)
+		if err != nil {
+			return participant.Filter{}, nil, err
+		}
+		f.ShortlistedBy = sp.CompanyID
+	}
+	return reserveResumes(ctx, f)
+}
+
+// chunkWriter is an io.Writer that sends everything written to it on a
+// StreamResumes stream in chunks of chunkSize bytes
+type chunkWriter struct {
+	stream api.SponsorService_StreamResumesServer
+	buf    []byte
+}
+
+func (cw *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		free := chunkSize - len(cw.buf)
+		if free > len(p) {
+			free = len(p)
+		}
+		cw.buf = append(cw.buf, p[:free]...)
+		p = p[free:]
+		if len(cw.buf) == chunkSize {
+			if err := cw.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (cw *chunkWriter) flush() error {
+	if len(cw.buf) == 0 {
+		return nil
+	}
+	err := cw.stream.Send(&api.ResumesChunk{
+		Data: cw.buf,
+	})
+	cw.buf = make([]byte, 0, chunkSize)
+	return err
+}
+
+// resumesArchiveHandler serves the archive of resumes as a plain HTTP file
+// download on the gateway. It forwards the request to the StreamResumes RPC
+// so that the request is authenticated by the gRPC interceptors
+func resumesArchiveHandler(client api.SponsorServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed),
+				http.StatusMethodNotAllowed)
+			return
+		}
+		// the query parameters are the same as the ones of the Resumes RPC
+		// e.g. ?format=zip&shortlisted=true or ?participant_ids=a&participant_ids=b
+		req := &api.ResumesRequest{}
+		if err := runtime.PopulateQueryParameters(req, r.URL.Query(),
+			&utilities.DoubleArray{}); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Format == "" {
+			req.Format = participant.FormatTar
+		}
+		format := req.Format
+		if format != participant.FormatTar && format != participant.FormatZip {
+			http.Error(w, participant.ErrUnsupportedFormat.Error(), http.StatusBadRequest)
+			return
+		}
+		ctx := metadata.NewOutgoingContext(r.Context(),
+			metadata.Pairs("authorization", r.Header.Get("Authorization")))
+		stream, err := client.StreamResumes(ctx, req)
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+		// the status of the response can only be set before the first chunk
+		// so the first chunk is received before writing any headers
+		chunk, err := stream.Recv()
+		if err != nil && err != io.EOF {
+			writeStatusError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", participant.ContentType(format))
+		w.Header().Set("Content-Disposition",
+			fmt.Sprintf(`attachment; filename="resumes.%s"`, format))
+		for err == nil {
+			if _, err := w.Write(chunk.Data); err != nil {
+				return
+			}
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			chunk, err = stream.Recv()
+		}
+		if err != io.EOF {
+			log.GetLogger(r.Context()).Errorf("error while streaming resumes: %v", err)
+		}
+	}
+}
+
+// GetResume is a method on the rpcServer that returns the resume of a single
+// participant either inline or as a signed url. Every inline resume is
+// recorded as a view, a signed url is recorded when it is used
+func (ss *rpcServer) GetResume(ctx context.Context,
+	req *api.GetResumeRequest) (*api.GetResumeResponse, error) {
+	viewer, err := auth.PrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := participant.ByID(req.ParticipantId)
+	if err != nil {
+		return nil, err
+	}
+	if len(p.Resume) == 0 {
+		return nil, participant.ErrNoResume
+	}
+	_, res, err := reserveResumes(ctx, participant.Filter{IDs: []string{p.ID}})
+	if err != nil {
+		return nil, err
+	}
+	if !req.Inline {
+		expires := time.Now().Add(signedURLTTL)
+		return &api.GetResumeResponse{
+			Url:       auth.SignURL(ss.privKey, signedResumePath+p.ID, viewer.SubjectID, expires),
+			ExpiresAt: expires.Unix(),
+		}, nil
+	}
+	bb, contentType, err := participant.ResumeFile(ctx, p)
+	if err != nil {
+		res.releaseAll(ctx)
+		return nil, err
+	}
+	if err := participant.RecordView(p.ID, viewer.SubjectID, participant.ViaRPC); err != nil {
+		return nil, err
+	}
+	return &api.GetResumeResponse{
+		Data:        bb,
+		ContentType: contentType,
+	}, nil
+}
+
+// signedResumeHandler serves the resumes of the signed urls returned by
+// GetResume. The signature is the only authentication of the request
+func (ss *rpcServer) signedResumeHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed),
+			http.StatusMethodNotAllowed)
+		return
+	}
+	viewerID, err := auth.VerifyURL(ss.privKey, r.URL, time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	logger := log.GetLogger(r.Context())
+	p, err := participant.ByID(strings.TrimPrefix(r.URL.Path, signedResumePath))
+	if err == participant.ErrNotFound {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		logger.Errorf("error while getting participant: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError),
+			http.StatusInternalServerError)
+		return
+	}
+	bb, contentType, err := participant.ResumeFile(r.Context(), p)
+	if err != nil {
+		logger.Errorf("error while getting resume: %v", err)
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+	if err := participant.RecordView(p.ID, viewerID, participant.ViaSignedURL); err != nil {
+		logger.Errorf("error while recording resume view: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError),
+			http.StatusInternalServerError)
+		return
+	}
+	name := p.ResumeFileName(contentType)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, name))
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.Write(bb)
+}
+
+// writeStatusError writes a gRPC error as an HTTP error response
+func writeStatusError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
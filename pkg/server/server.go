@@ -19,10 +19,9 @@ var (
 	RPCAddr *string
 	// GatewayAddr is a flag that specifies the address at which the gateway will run
 	GatewayAddr *string
-)
-
-const (
-	tokenIssuer = "sponsor_auburnhacks"
+	// AppURL is a flag that specifies the URL of the web app that the invite
+	// and password reset emails link to
+	AppURL *string
 )
 
 // Server is a server that defines an interface for this package
@@ -33,8 +32,9 @@ type Server interface {
 	Stop() error
 }
 
-// New takes a private key for encryption and returns a struct
-// that implements the Server interface
+// New takes the private key that the resume URLs are signed with and returns
+// a struct that implements the Server interface. The tokens are signed with
+// auth.Keys
 func New(privKey []byte) Server {
 	log.Debugf("gateway address: %s", *GatewayAddr)
 	log.Debugf("rpc server addr: %s", *RPCAddr)
@@ -72,7 +72,10 @@ func (s *rpcServer) Stop() error {
 }
 
 func (s *rpcServer) serveGRPC(l net.Listener) {
-	s.grpcSrv = grpc.NewServer(grpc.UnaryInterceptor(utils.UnaryAuthInterceptor))
+	s.grpcSrv = grpc.NewServer(
+		grpc.UnaryInterceptor(utils.UnaryAuthInterceptor),
+		grpc.StreamInterceptor(utils.StreamAuthInterceptor),
+	)
 	api.RegisterSponsorServiceServer(s.grpcSrv, s)
 	if err := s.grpcSrv.Serve(l); err != nil {
 		log.Errorf("error while serving grpc server: %v", err)
@@ -93,9 +96,22 @@ func (s *rpcServer) serveGateway(listenAddr, serviceEndpoint string) {
 	if err != nil {
 		log.Fatal(err)
 	}
+	// streaming downloads are served by plain HTTP handlers that call the
+	// gRPC server since the gateway can only stream JSON
+	conn, err := grpc.Dial(serviceEndpoint, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+	client := api.NewSponsorServiceClient(conn)
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/v1/sponsor/participants/resumes/archive", resumesArchiveHandler(client))
+	httpMux.HandleFunc(signedResumePath, s.signedResumeHandler)
+	httpMux.HandleFunc(jwksPath, jwksHandler)
+	httpMux.Handle("/", mux)
 	s.gwSrv = &http.Server{
 		Addr:    listenAddr,
-		Handler: mux,
+		Handler: httpMux,
 	}
 	if err := s.gwSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		log.Errorf("error while serving gateway: %v", err)
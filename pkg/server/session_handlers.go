@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/auburnhacks/sponsor/pkg/admin"
+	"github.com/auburnhacks/sponsor/pkg/auth"
+	"github.com/auburnhacks/sponsor/pkg/log"
+	"github.com/auburnhacks/sponsor/pkg/session"
+	"github.com/auburnhacks/sponsor/pkg/sponsor"
+	api "github.com/auburnhacks/sponsor/proto"
+)
+
+// signToken signs the claims into an access token
+func (ss *rpcServer) signToken(cl auth.Claims) (string, error) {
+	return auth.Keys.Sign(cl)
+}
+
+// startSession signs the claims into an access token and starts a session
+// with a refresh token that is used to get the next access token
+func (ss *rpcServer) startSession(cl auth.Claims) (string, string, error) {
+	token, err := ss.signToken(cl)
+	if err != nil {
+		return "", "", err
+	}
+	refresh, err := session.Create(cl.Principal().Kind, cl.ID())
+	if err != nil {
+		return "", "", err
+	}
+	return token, refresh, nil
+}
+
+// sessionClaims returns fresh claims for the user of a session so that a
+// change to the roles of a user applies to the next access token
+func sessionClaims(s *session.Session) (auth.Claims, error) {
+	switch s.Kind {
+	case auth.KindAdmin:
+		a, err := admin.ByID(s.SubjectID)
+		if err != nil {
+			return nil, err
+		}
+		return auth.NewAdmin(a.ID, a.ACL), nil
+	case auth.KindSponsor:
+		sp, err := sponsor.ByID(s.SubjectID)
+		if err != nil {
+			return nil, err
+		}
+		c, err := sponsor.CompanyByID(sp.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+		return auth.NewSponsor(sp.ID, c.ID, sp.ACL, c.Tier), nil
+	}
+	return nil, session.ErrInvalidRefreshToken
+}
+
+// RefreshToken is a method on the rpcServer that exchanges a refresh token
+// for a new access token, the refresh token is rotated on every call
+func (ss *rpcServer) RefreshToken(ctx context.Context,
+	req *api.RefreshTokenRequest) (*api.RefreshTokenResponse, error) {
+	s, refresh, err := session.Rotate(req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	cl, err := sessionClaims(s)
+	if err != nil {
+		return nil, err
+	}
+	token, err := ss.signToken(cl)
+	if err != nil {
+		return nil, err
+	}
+	return &api.RefreshTokenResponse{
+		Token:        token,
+		RefreshToken: refresh,
+		ExpiresAt:    cl.Expiry().Unix(),
+	}, nil
+}
+
+// Logout is a method on the rpcServer that revokes the access token of the
+// request and the session of the refresh token. Every session of the user is
+// revoked when everywhere is set
+func (ss *rpcServer) Logout(ctx context.Context,
+	req *api.LogoutRequest) (*api.LogoutResponse, error) {
+	cl, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Everywhere {
+		if err := session.RevokeSubject(cl.ID()); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := session.RevokeToken(cl.TokenID(), cl.Expiry()); err != nil {
+			return nil, err
+		}
+		if len(req.RefreshToken) > 0 {
+			if err := session.Revoke(req.RefreshToken); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &api.LogoutResponse{
+		Ok: true,
+	}, nil
+}
+
+// jwksPath is the path of the JWKS endpoint on the gateway
+const jwksPath = "/.well-known/jwks.json"
+
+// jwksHandler serves the public keys that verify the tokens issued by the
+// server so that other services can verify them
+func jwksHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	if err := json.NewEncoder(w).Encode(auth.Keys.JWKS()); err != nil {
+		log.GetLogger(r.Context()).Errorf("error while writing jwks: %v", err)
+	}
+}
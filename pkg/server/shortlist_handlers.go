@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+
+	"github.com/auburnhacks/sponsor/pkg/auth"
+	"github.com/auburnhacks/sponsor/pkg/pagination"
+	"github.com/auburnhacks/sponsor/pkg/participant"
+	"github.com/auburnhacks/sponsor/pkg/sponsor"
+	api "github.com/auburnhacks/sponsor/proto"
+	"github.com/pkg/errors"
+)
+
+// errNotSponsor is returned by RPC calls that can only be made by a sponsor
+var errNotSponsor = errors.New("server: only sponsors are allowed to make this call")
+
+// sponsorFromContext returns the sponsor that is making the request
+func sponsorFromContext(ctx context.Context) (*sponsor.Sponsor, error) {
+	p, err := auth.PrincipalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !p.IsSponsor() {
+		return nil, errNotSponsor
+	}
+	sp, err := sponsor.ByID(p.SubjectID)
+	if err != nil {
+		return nil, errNotSponsor
+	}
+	return sp, nil
+}
+
+// AddToShortlist is a method on the rpcServer that adds a participant to the
+// shortlist of the company of the sponsor making the request
+func (ss *rpcServer) AddToShortlist(ctx context.Context,
+	req *api.AddToShortlistRequest) (*api.AddToShortlistResponse, error) {
+	sp, err := sponsorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := sp.AddToShortlist(req.ParticipantId); err != nil {
+		return nil, err
+	}
+	return &api.AddToShortlistResponse{
+		Ok: true,
+	}, nil
+}
+
+// RemoveFromShortlist is a method on the rpcServer that removes a participant
+// from the shortlist of the company of the sponsor making the request
+func (ss *rpcServer) RemoveFromShortlist(ctx context.Context,
+	req *api.RemoveFromShortlistRequest) (*api.RemoveFromShortlistResponse, error) {
+	sp, err := sponsorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := sp.RemoveFromShortlist(req.ParticipantId); err != nil {
+		return nil, err
+	}
+	return &api.RemoveFromShortlistResponse{
+		Ok: true,
+	}, nil
+}
+
+// ListShortlist is a method on the rpcServer that lists all the participants
+// on the shortlist of the company of the sponsor making the request
+func (ss *rpcServer) ListShortlist(ctx context.Context,
+	req *api.ListShortlistRequest) (*api.ListShortlistResponse, error) {
+	sp, err := sponsorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pg, err := pagination.New(req.PageToken, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	pSlice, next, err := participant.List(participant.Filter{
+		ShortlistedBy: sp.CompanyID,
+	}, pg)
+	if err != nil {
+		return nil, err
+	}
+	prp := make([]*api.Participant, len(pSlice))
+	for i, p := range pSlice {
+		prp[i] = apiParticipant(p)
+	}
+	return &api.ListShortlistResponse{
+		Participants:  prp,
+		NextPageToken: next,
+	}, nil
+}
@@ -5,12 +5,38 @@ import (
 
 	"github.com/auburnhacks/sponsor/pkg/auth"
 	"github.com/auburnhacks/sponsor/pkg/participant"
+	"github.com/auburnhacks/sponsor/pkg/session"
 	"github.com/auburnhacks/sponsor/pkg/sponsor"
 	api "github.com/auburnhacks/sponsor/proto"
-	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
+// managedRoles are the roles that a sponsor manager can give to the sponsors
+// of their company
+var managedRoles = []auth.Role{auth.RoleSponsorRecruiter, auth.RoleReadOnly}
+
+// errManagedSponsor is returned when a sponsor manager changes something that
+// only an admin can change
+var errManagedSponsor = errors.New("server: sponsor managers can only change the name and the " +
+	"recruiter or read-only roles of the other sponsors of their company")
+
+// authorizeManagedUpdate returns an error when a sponsor manager is not
+// allowed to make the update to a sponsor. A manager can only change the name
+// and roles of the recruiters and read-only sponsors of their company, the
+// email and password can only be changed by an admin or through a reset
+func authorizeManagedUpdate(ctx context.Context, s *sponsor.Sponsor, update *api.Sponsor) error {
+	if err := authorizeCompany(ctx, s.CompanyID); err != nil {
+		return err
+	}
+	if auth.ValidateACL(s.ACL, managedRoles) != nil ||
+		auth.ValidateACL(update.ACL, managedRoles) != nil ||
+		update.Email != s.Email || len(update.Password) > 0 {
+		return errManagedSponsor
+	}
+	return nil
+}
+
 // LoginSponsor is a method on the rpcServer that is used to validate and
 // login a sponsor and issue a JWT token
 func (ss *rpcServer) LoginSponsor(ctx context.Context,
@@ -23,14 +49,15 @@ func (ss *rpcServer) LoginSponsor(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	cl := auth.New(sp.ID, sp.ACL)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, cl)
-	tokenStr, err := token.SignedString(ss.privKey)
+	cl := auth.NewSponsor(sp.ID, c.ID, sp.ACL, c.Tier)
+	tokenStr, refresh, err := ss.startSession(cl)
 	if err != nil {
 		return nil, err
 	}
 	return &api.LoginSponsorResponse{
-		Token: tokenStr,
+		Token:        tokenStr,
+		RefreshToken: refresh,
+		ExpiresAt:    cl.Expiry().Unix(),
 		Sponsor: &api.Sponsor{
 			Id:    sp.ID,
 			Name:  sp.Name,
@@ -46,10 +73,22 @@ func (ss *rpcServer) LoginSponsor(ctx context.Context,
 }
 
 // CreateSponsor is a method on the rpcServer that is used to create a sponsor
-// this is typically called by an admin
+// this is typically called by an admin. A sponsor created without a password
+// is emailed an invite to set one
 // NOTE (kirandasika98): This can change later based on a new feature change
 func (ss *rpcServer) CreateSponsor(ctx context.Context,
 	req *api.CreateSponsorRequest) (*api.CreateSponsorResponse, error) {
+	if req.Sponsor == nil || req.Sponsor.Company == nil {
+		return nil, errMissingField("sponsor.company")
+	}
+	if !hasPermission(ctx, auth.PermSponsorsWrite) {
+		if err := authorizeCompany(ctx, req.Sponsor.Company.Id); err != nil {
+			return nil, err
+		}
+		if err := auth.ValidateACL(req.Sponsor.ACL, managedRoles); err != nil {
+			return nil, errManagedSponsor
+		}
+	}
 	c, err := sponsor.CompanyByID(req.Sponsor.Company.Id)
 	if err != nil {
 		return nil, err
@@ -59,7 +98,17 @@ func (ss *rpcServer) CreateSponsor(ctx context.Context,
 	if err := s.Register(); err != nil {
 		return nil, err
 	}
+	// a sponsor without a password is invited to pick their own
+	inviteSent := false
+	if len(req.Sponsor.Password) == 0 {
+		if err := sendInvite(ctx, s, c); err != nil {
+			log.Errorf("error while sending invite to sponsor %s: %v", s.ID, err)
+		} else {
+			inviteSent = true
+		}
+	}
 	return &api.CreateSponsorResponse{
+		InviteSent: inviteSent,
 		Sponsor: &api.Sponsor{
 			Id:    s.ID,
 			Name:  s.Name,
@@ -83,6 +132,11 @@ func (ss *rpcServer) GetSponsor(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if !hasPermission(ctx, auth.PermSponsorsRead) {
+		if err := authorizeCompany(ctx, s.CompanyID); err != nil {
+			return nil, err
+		}
+	}
 	c, err := sponsor.CompanyByID(s.CompanyID)
 	if err != nil {
 		return nil, err
@@ -106,17 +160,38 @@ func (ss *rpcServer) GetSponsor(ctx context.Context,
 // UpdateSponsor is a method on the rpcServer that is used to modify a
 // state of a sponsor in the database
 func (ss *rpcServer) UpdateSponsor(ctx context.Context, req *api.UpdateSponsorRequest) (*api.UpdateSponsorResponse, error) {
+	if req.Sponsor == nil {
+		return nil, errMissingField("sponsor")
+	}
 	s, err := sponsor.ByID(req.SponsorId)
 	if err != nil {
 		return nil, err
 	}
+	if !hasPermission(ctx, auth.PermSponsorsWrite) {
+		if err := authorizeManagedUpdate(ctx, s, req.Sponsor); err != nil {
+			return nil, err
+		}
+	}
 	log.Debugf("%+v", s)
+	aclChanged := s.ACL != req.Sponsor.ACL
 	s.Name = req.Sponsor.Name
 	s.Email = req.Sponsor.Email
 	s.ACL = req.Sponsor.ACL
+	if len(req.Sponsor.Password) > 0 {
+		if err := s.SetPassword(req.Sponsor.Password); err != nil {
+			return nil, err
+		}
+	}
 	if err := s.Save(); err != nil {
 		return nil, err
 	}
+	// a new password or new roles log the sponsor out of every session so
+	// that the old roles are not used anymore
+	if len(req.Sponsor.Password) > 0 || aclChanged {
+		if err := session.RevokeSubject(s.ID); err != nil {
+			return nil, err
+		}
+	}
 	c, err := sponsor.CompanyByID(s.CompanyID)
 	if err != nil {
 		return nil, err
@@ -135,29 +210,70 @@ func (ss *rpcServer) UpdateSponsor(ctx context.Context, req *api.UpdateSponsorRe
 	}, nil
 }
 
-// CreateCompany creates a company and saved it to the database
-func (ss *rpcServer) CreateCompany(ctx context.Context,
-	req *api.CreateCompanyRequest) (*api.CreateCompanyResponse, error) {
-	c := sponsor.NewCompany(req.Name, req.Logo)
+// DeleteSponsor is a method on the rpcServer that is used to delete a sponsor,
+// it can only be called by an admin
+func (ss *rpcServer) DeleteSponsor(ctx context.Context,
+	req *api.DeleteSponsorRequest) (*api.DeleteSponsorResponse, error) {
+	if _, err := adminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	s, err := sponsor.ByID(req.SponsorId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Delete(); err != nil {
+		return nil, err
+	}
+	// the tokens of the sponsor must stop working right away
+	if err := session.RevokeSubject(s.ID); err != nil {
+		return nil, err
+	}
+	return &api.DeleteSponsorResponse{
+		Ok: true,
+	}, nil
+}
 
-	// Authorization stuff for admin
-	cl, err := auth.FromContext(ctx)
+// DeleteCompany is a method on the rpcServer that is used to delete a company
+// along with all of its sponsors, it can only be called by an admin
+func (ss *rpcServer) DeleteCompany(ctx context.Context,
+	req *api.DeleteCompanyRequest) (*api.DeleteCompanyResponse, error) {
+	if _, err := adminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	c, err := sponsor.CompanyByID(req.CompanyId)
 	if err != nil {
 		return nil, err
 	}
-	if err := cl.Claim("update"); err != nil {
+	if err := c.Delete(); err != nil {
+		return nil, err
+	}
+	ids, err := c.SponsorIDs()
+	if err != nil {
 		return nil, err
 	}
+	for _, id := range ids {
+		if err := session.RevokeSubject(id); err != nil {
+			return nil, err
+		}
+	}
+	return &api.DeleteCompanyResponse{
+		Ok: true,
+	}, nil
+}
+
+// CreateCompany creates a company and saved it to the database
+func (ss *rpcServer) CreateCompany(ctx context.Context,
+	req *api.CreateCompanyRequest) (*api.CreateCompanyResponse, error) {
+	c := sponsor.NewCompany(req.Name, req.Logo)
+	if len(req.Tier) > 0 {
+		c.Tier = req.Tier
+	}
 
 	if err := c.Save(); err != nil {
 		return nil, err
 	}
 	return &api.CreateCompanyResponse{
-		Company: &api.Company{
-			Id:   c.ID,
-			Name: c.Name,
-			Logo: c.Logo,
-		},
+		Company: apiCompany(c),
 	}, nil
 }
 
@@ -165,10 +281,16 @@ func (ss *rpcServer) CreateCompany(ctx context.Context,
 // bytes
 func (ss *rpcServer) Resumes(ctx context.Context,
 	req *api.ResumesRequest) (*api.ResumesResponse, error) {
-	b, err := participant.AllResumes()
+	f, res, err := resumesFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	b, failed, err := participant.AllResumes(f)
 	if err != nil {
+		res.releaseAll(ctx)
 		return nil, err
 	}
+	res.releaseFailed(ctx, failed)
 	return &api.ResumesResponse{
 		Archive: b,
 	}, nil
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/auburnhacks/sponsor/pkg/participant"
+	api "github.com/auburnhacks/sponsor/proto"
+)
+
+// GetSyncStatus is a method on the rpcServer that returns the status of the
+// most recent sync of the participants from the external database
+func (s *rpcServer) GetSyncStatus(ctx context.Context,
+	req *api.GetSyncStatusRequest) (*api.GetSyncStatusResponse, error) {
+	if _, err := adminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	st := participant.CurrentStatus()
+	apiSt := &api.SyncStatus{
+		Source:     st.Source,
+		Running:    st.Running,
+		Full:       st.Full,
+		DurationMs: int64(st.Duration / time.Millisecond),
+		Saved:      int32(st.Saved),
+		Deleted:    int32(st.Deleted),
+		Mirrored:   int32(st.Mirrored),
+		Failures:   st.Failed,
+		LastError:  st.LastError,
+	}
+	if !st.LastStart.IsZero() {
+		apiSt.LastStart = st.LastStart.Unix()
+	}
+	if !st.LastFinish.IsZero() {
+		apiSt.LastFinish = st.LastFinish.Unix()
+	}
+	return &api.GetSyncStatusResponse{
+		Status: apiSt,
+	}, nil
+}
+
+// TriggerSync is a method on the rpcServer that starts a sync of the
+// participants immediately instead of waiting for the next sync period
+func (s *rpcServer) TriggerSync(ctx context.Context,
+	req *api.TriggerSyncRequest) (*api.TriggerSyncResponse, error) {
+	if _, err := adminFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if err := participant.Trigger(req.Full); err != nil {
+		return nil, err
+	}
+	return &api.TriggerSyncResponse{
+		Ok: true,
+	}, nil
+}
@@ -0,0 +1,217 @@
+// Package session keeps track of the refresh tokens that are issued when a
+// user logs in, of the access tokens that were revoked before they expired and
+// of the single use tokens that are emailed to users
+package session
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/auburnhacks/sponsor/pkg/auth"
+	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// RefreshTokenTTL is how long a refresh token can be used, a user has to log
+// in again once it has expired
+var RefreshTokenTTL = 30 * 24 * time.Hour
+
+var (
+	// ErrInvalidRefreshToken is returned when a refresh token does not exist,
+	// has expired or has been revoked
+	ErrInvalidRefreshToken = errors.New("pkg/session: invalid refresh token")
+	// ErrRefreshTokenReused is returned when a refresh token that was already
+	// rotated is used again, every token of its family is revoked since the
+	// token has most likely been stolen
+	ErrRefreshTokenReused = errors.New("pkg/session: refresh token reused, please log in again")
+)
+
+// Session is the user that a refresh token was issued to
+type Session struct {
+	ID        string      `db:"id"`
+	FamilyID  string      `db:"family_id"`
+	Kind      auth.Kind   `db:"subject_kind"`
+	SubjectID string      `db:"subject_id"`
+	ExpiresAt time.Time   `db:"expires_at"`
+	UsedAt    pq.NullTime `db:"used_at"`
+	RevokedAt pq.NullTime `db:"revoked_at"`
+}
+
+// Create starts a new session for the user and returns its refresh token
+func Create(kind auth.Kind, subjectID string) (string, error) {
+	return create(db.Conn, uuid.New().String(), kind, subjectID)
+}
+
+// create inserts a refresh token of the family and returns it
+func create(e sqlx.Ext, familyID string, kind auth.Kind, subjectID string) (string, error) {
+	token, err := newToken()
+	if err != nil {
+		return "", err
+	}
+	_, err = sqlx.NamedExec(e, `
+	INSERT INTO refresh_tokens
+	(token_hash, family_id, subject_kind, subject_id, expires_at)
+	VALUES (:token_hash, :family_id, :subject_kind, :subject_id,
+		NOW() + :ttl * INTERVAL '1 second')`, map[string]interface{}{
+		"token_hash":   hash(token),
+		"family_id":    familyID,
+		"subject_kind": kind,
+		"subject_id":   subjectID,
+		"ttl":          int64(RefreshTokenTTL / time.Second),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "pkg/session: error while saving refresh token")
+	}
+	return token, nil
+}
+
+// Rotate uses up a refresh token and returns its session along with the
+// refresh token that replaces it
+func Rotate(token string) (*Session, string, error) {
+	tx, err := db.Conn.Beginx()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "pkg/session: error while starting transaction")
+	}
+	defer tx.Rollback()
+	s := new(Session)
+	err = tx.Get(s, `
+	SELECT id, family_id, subject_kind, subject_id, expires_at, used_at, revoked_at
+	FROM refresh_tokens WHERE token_hash = $1 AND expires_at > NOW()
+	FOR UPDATE`, hash(token))
+	if err == sql.ErrNoRows || (err == nil && s.RevokedAt.Valid) {
+		return nil, "", ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, "", errors.Wrap(err, "pkg/session: error while getting refresh token")
+	}
+	if s.UsedAt.Valid {
+		if err := revokeFamily(tx, s.FamilyID); err != nil {
+			return nil, "", err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, "", errors.Wrap(err, "pkg/session: error while committing revoke")
+		}
+		return nil, "", ErrRefreshTokenReused
+	}
+	_, err = tx.Exec(`UPDATE refresh_tokens SET used_at = NOW() WHERE id = $1`, s.ID)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "pkg/session: error while using refresh token")
+	}
+	next, err := create(tx, s.FamilyID, s.Kind, s.SubjectID)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, "", errors.Wrap(err, "pkg/session: error while committing rotation")
+	}
+	return s, next, nil
+}
+
+// Revoke revokes the refresh token along with every token of its family, it
+// does nothing when the token does not exist
+func Revoke(token string) error {
+	var familyID string
+	err := db.Conn.Get(&familyID, `SELECT family_id FROM refresh_tokens
+	WHERE token_hash = $1`, hash(token))
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "pkg/session: error while getting refresh token")
+	}
+	return revokeFamily(db.Conn, familyID)
+}
+
+// revokeFamily revokes all the refresh tokens of a family
+func revokeFamily(e sqlx.Execer, familyID string) error {
+	_, err := e.Exec(`UPDATE refresh_tokens SET revoked_at = NOW()
+	WHERE family_id = $1 AND revoked_at IS NULL`, familyID)
+	if err != nil {
+		return errors.Wrap(err, "pkg/session: error while revoking refresh tokens")
+	}
+	return nil
+}
+
+// RevokeToken revokes a single access token until it expires
+func RevokeToken(jti string, expiresAt time.Time) error {
+	_, err := db.Conn.Exec(`
+	INSERT INTO revoked_tokens (jti, expires_at) VALUES ($1, to_timestamp($2))
+	ON CONFLICT (jti) DO NOTHING`, jti, expiresAt.Unix())
+	if err != nil {
+		return errors.Wrap(err, "pkg/session: error while revoking token")
+	}
+	// the expired tokens are rejected anyway so they do not have to be kept
+	_, err = db.Conn.Exec(`DELETE FROM revoked_tokens WHERE expires_at < NOW()`)
+	if err != nil {
+		return errors.Wrap(err, "pkg/session: error while removing expired tokens")
+	}
+	return nil
+}
+
+// RevokeSubject revokes every refresh token of a user and every access token
+// that was issued to the user until now
+func RevokeSubject(subjectID string) error {
+	tx, err := db.Conn.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "pkg/session: error while starting transaction")
+	}
+	defer tx.Rollback()
+	_, err = tx.Exec(`UPDATE refresh_tokens SET revoked_at = NOW()
+	WHERE subject_id::text = $1 AND revoked_at IS NULL`, subjectID)
+	if err != nil {
+		return errors.Wrap(err, "pkg/session: error while revoking refresh tokens")
+	}
+	_, err = tx.Exec(`
+	INSERT INTO revoked_subjects (subject_id, revoked_before) VALUES ($1, NOW())
+	ON CONFLICT (subject_id) DO UPDATE SET revoked_before = NOW()`, subjectID)
+	if err != nil {
+		return errors.Wrap(err, "pkg/session: error while revoking tokens")
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "pkg/session: error while committing revoke")
+	}
+	return nil
+}
+
+// Revocations is the revocation list of the revoked tokens and users that is
+// stored in the database. It implements auth.RevocationList
+type Revocations struct{}
+
+// Revoked reports whether the access token has been revoked on its own or
+// was issued to the user before all the tokens of the user were revoked
+// NOTE: the issue time of a token is in seconds so a token issued in the same
+// second as the revocation is revoked as well
+func (Revocations) Revoked(jti, subjectID string, issuedAt time.Time) (bool, error) {
+	var revoked bool
+	err := db.Conn.Get(&revoked, `SELECT
+	EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1) OR
+	EXISTS (SELECT 1 FROM revoked_subjects WHERE subject_id = $2
+		AND date_trunc('second', revoked_before) >= to_timestamp($3)::timestamp)`,
+		jti, subjectID, issuedAt.Unix())
+	if err != nil {
+		return false, errors.Wrap(err, "pkg/session: error while checking revocations")
+	}
+	return revoked, nil
+}
+
+// newToken returns a random refresh token
+func newToken() (string, error) {
+	bb := make([]byte, 32)
+	if _, err := rand.Read(bb); err != nil {
+		return "", errors.Wrap(err, "pkg/session: error while generating token")
+	}
+	return base64.RawURLEncoding.EncodeToString(bb), nil
+}
+
+// hash returns the hash of a refresh token that is stored in the database
+func hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
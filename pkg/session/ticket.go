@@ -0,0 +1,115 @@
+package session
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/auburnhacks/sponsor/pkg/auth"
+	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// Purpose is what a ticket can be redeemed for
+type Purpose string
+
+// All the purposes of a ticket
+const (
+	PurposeInvite Purpose = "invite"
+	PurposeReset  Purpose = "reset"
+)
+
+var (
+	// InviteTTL is how long an invite can be accepted
+	InviteTTL = 7 * 24 * time.Hour
+	// ResetTTL is how long a password reset token can be used
+	ResetTTL = time.Hour
+	// ResetInterval is the minimum time between two password resets of a
+	// user so that the reset emails can not be used to flood an inbox
+	ResetInterval = 5 * time.Minute
+)
+
+// ErrInvalidTicket is returned when a ticket does not exist, has expired or
+// has already been used
+var ErrInvalidTicket = errors.New("pkg/session: invalid or expired token")
+
+// Ticket is the user that a single use token was issued to
+type Ticket struct {
+	ID        string    `db:"id"`
+	Purpose   Purpose   `db:"purpose"`
+	Kind      auth.Kind `db:"subject_kind"`
+	SubjectID string    `db:"subject_id"`
+}
+
+// IssueTicket returns a single use token for the purpose that is valid for
+// ttl, the token is meant to be emailed to the user
+func IssueTicket(purpose Purpose, kind auth.Kind, subjectID string, ttl time.Duration) (string, error) {
+	token, err := newToken()
+	if err != nil {
+		return "", err
+	}
+	_, err = db.Conn.NamedExec(`
+	INSERT INTO tickets
+	(token_hash, purpose, subject_kind, subject_id, expires_at)
+	VALUES (:token_hash, :purpose, :subject_kind, :subject_id,
+		NOW() + :ttl * INTERVAL '1 second')`, map[string]interface{}{
+		"token_hash":   hash(token),
+		"purpose":      purpose,
+		"subject_kind": kind,
+		"subject_id":   subjectID,
+		"ttl":          int64(ttl / time.Second),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "pkg/session: error while saving ticket")
+	}
+	return token, nil
+}
+
+// IssuedWithin reports whether a ticket for the purpose that can still be
+// redeemed was issued to the user within d
+func IssuedWithin(purpose Purpose, subjectID string, d time.Duration) (bool, error) {
+	var issued bool
+	err := db.Conn.Get(&issued, `SELECT EXISTS (SELECT 1 FROM tickets
+	WHERE subject_id = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+	AND created_at > NOW() - $3 * INTERVAL '1 second')`,
+		subjectID, purpose, int64(d/time.Second))
+	if err != nil {
+		return false, errors.Wrap(err, "pkg/session: error while checking tickets")
+	}
+	return issued, nil
+}
+
+// RedeemTicket uses up the ticket of the token along with every other ticket
+// of the user for the same purpose and returns it. The ticket is used by use
+// in the same transaction so that it is only used up when use succeeds
+func RedeemTicket(purpose Purpose, token string, use func(tx *sqlx.Tx, t *Ticket) error) (*Ticket, error) {
+	tx, err := db.Conn.Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "pkg/session: error while starting transaction")
+	}
+	defer tx.Rollback()
+	t := new(Ticket)
+	err = tx.Get(t, `
+	SELECT id, purpose, subject_kind, subject_id FROM tickets
+	WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL
+	AND expires_at > NOW()
+	FOR UPDATE`, hash(token), purpose)
+	if err == sql.ErrNoRows {
+		return nil, ErrInvalidTicket
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "pkg/session: error while getting ticket")
+	}
+	_, err = tx.Exec(`UPDATE tickets SET used_at = NOW()
+	WHERE subject_id = $1 AND purpose = $2 AND used_at IS NULL`, t.SubjectID, purpose)
+	if err != nil {
+		return nil, errors.Wrap(err, "pkg/session: error while using ticket")
+	}
+	if err := use(tx, t); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "pkg/session: error while committing ticket")
+	}
+	return t, nil
+}
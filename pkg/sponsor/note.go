@@ -0,0 +1,173 @@
+package sponsor
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/auburnhacks/sponsor/pkg/pagination"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNoteNotFound is an error returned when a note does not exist or
+	// belongs to a different company
+	ErrNoteNotFound = errors.New("pkg/sponsor: note not found")
+	// ErrNotNoteAuthor is an error returned when a sponsor tries to modify a
+	// note written by someone else
+	ErrNotNoteAuthor = errors.New("pkg/sponsor: only the author can modify a note")
+	// ErrInvalidRating is an error returned when a rating is out of range
+	ErrInvalidRating = errors.New("pkg/sponsor: rating should be between 1 and 5")
+)
+
+// Note is a private note and rating that a sponsor attaches to a participant.
+// Notes are only visible to the sponsors of the company of the author
+type Note struct {
+	ID            string `db:"id"`
+	ParticipantID string `db:"participant_id"`
+	CompanyID     string `db:"company_id"`
+	SponsorID     string `db:"sponsor_id"`
+	Body          string `db:"body"`
+	// Rating is nil when the participant was not rated
+	Rating    *int      `db:"rating"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// NewNote returns an instance of a note written by the sponsor
+func (s *Sponsor) NewNote(participantID, body string, rating *int) *Note {
+	return &Note{
+		ParticipantID: participantID,
+		CompanyID:     s.CompanyID,
+		SponsorID:     s.ID,
+		Body:          body,
+		Rating:        rating,
+	}
+}
+
+func validRating(rating *int) error {
+	if rating != nil && (*rating < 1 || *rating > 5) {
+		return ErrInvalidRating
+	}
+	return nil
+}
+
+// Create saves a new note to the database
+func (n *Note) Create() error {
+	if err := validRating(n.Rating); err != nil {
+		return err
+	}
+	query := `
+	INSERT INTO participant_notes(participant_id, company_id, sponsor_id, body, rating)
+	VALUES(:participant_id, :company_id, :sponsor_id, :body, :rating)
+	RETURNING id, created_at, updated_at`
+	stmt, err := db.Conn.PrepareNamed(query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	err = stmt.QueryRowx(n).Scan(&n.ID, &n.CreatedAt, &n.UpdatedAt)
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while creating note")
+	}
+	return nil
+}
+
+// Save saves the modified body and rating of a note to the database
+func (n *Note) Save() error {
+	if err := validRating(n.Rating); err != nil {
+		return err
+	}
+	query := `
+	UPDATE participant_notes
+	SET body = :body, rating = :rating, updated_at = NOW()
+	WHERE id = :id
+	RETURNING updated_at`
+	stmt, err := db.Conn.PrepareNamed(query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	err = stmt.QueryRowx(n).Scan(&n.UpdatedAt)
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while saving note")
+	}
+	return nil
+}
+
+// Delete deletes a note from the database
+func (n *Note) Delete() error {
+	_, err := db.Conn.NamedExec(`DELETE FROM participant_notes WHERE id = :id`, n)
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while deleting note")
+	}
+	return nil
+}
+
+// CanModify returns an error if the sponsor is not the author of the note
+func (n *Note) CanModify(s *Sponsor) error {
+	if n.SponsorID != s.ID || n.CompanyID != s.CompanyID {
+		return ErrNotNoteAuthor
+	}
+	return nil
+}
+
+// NoteByID fetches a note that belongs to the given company
+func NoteByID(noteID, companyID string) (*Note, error) {
+	query := `
+	SELECT id, participant_id, company_id, sponsor_id, body, rating, created_at, updated_at
+	FROM participant_notes
+	WHERE id = :id AND company_id = :company_id`
+	stmt, err := db.Conn.PrepareNamed(query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	n := new(Note)
+	err = stmt.Get(n, map[string]interface{}{
+		"id":         noteID,
+		"company_id": companyID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, ErrNoteNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// ListNotes fetches a page of the notes that the sponsors of a company have
+// written about a participant along with the token for the next page
+func ListNotes(companyID, participantID string, pg pagination.Page) ([]*Note, string, error) {
+	args := map[string]interface{}{
+		"company_id":     companyID,
+		"participant_id": participantID,
+	}
+	query := `
+	SELECT id, participant_id, company_id, sponsor_id, body, rating, created_at, updated_at
+	FROM participant_notes
+	WHERE company_id = :company_id AND participant_id = :participant_id `
+	if cond := pg.Condition(args); len(cond) > 0 {
+		query += "AND " + cond + " "
+	}
+	query += pg.Clause(args)
+	rows, err := db.Conn.NamedQuery(query, args)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	var notes []*Note
+	for rows.Next() {
+		n := new(Note)
+		if err := rows.StructScan(n); err != nil {
+			return nil, "", errors.Wrap(err,
+				"pkg/sponsor: error while scanning rows for notes")
+		}
+		notes = append(notes, n)
+	}
+	c, next := pg.Next(len(notes), func(i int) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: notes[i].CreatedAt, ID: notes[i].ID}
+	})
+	return notes[:c], next, nil
+}
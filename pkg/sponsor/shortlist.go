@@ -0,0 +1,52 @@
+package sponsor
+
+import (
+	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/pkg/errors"
+)
+
+// ErrNotShortlisted is an error returned when a participant is removed from
+// a shortlist that they are not a part of
+var ErrNotShortlisted = errors.New("pkg/sponsor: participant is not shortlisted")
+
+// AddToShortlist adds a participant to the shortlist of the company that the
+// sponsor belongs to. Adding a participant that is already shortlisted by the
+// company is not an error
+func (s *Sponsor) AddToShortlist(participantID string) error {
+	query := `
+	INSERT INTO shortlists(company_id, sponsor_id, participant_id)
+	VALUES(:company_id, :sponsor_id, :participant_id)
+	ON CONFLICT (company_id, participant_id) DO NOTHING`
+	_, err := db.Conn.NamedExec(query, map[string]interface{}{
+		"company_id":     s.CompanyID,
+		"sponsor_id":     s.ID,
+		"participant_id": participantID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while adding to shortlist")
+	}
+	return nil
+}
+
+// RemoveFromShortlist removes a participant from the shortlist of the company
+// that the sponsor belongs to
+func (s *Sponsor) RemoveFromShortlist(participantID string) error {
+	query := `
+	DELETE FROM shortlists
+	WHERE company_id = :company_id AND participant_id = :participant_id`
+	res, err := db.Conn.NamedExec(query, map[string]interface{}{
+		"company_id":     s.CompanyID,
+		"participant_id": participantID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while removing from shortlist")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotShortlisted
+	}
+	return nil
+}
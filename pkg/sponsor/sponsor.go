@@ -3,17 +3,22 @@
 package sponsor
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
+	"github.com/auburnhacks/sponsor/pkg/auth"
 	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/auburnhacks/sponsor/pkg/pagination"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 )
 
 // DefaultACL is a variables that is used for all admins if no ACL list is
 // provided during signup
-var DefaultACL = "read"
+var DefaultACL = string(auth.RoleSponsorRecruiter)
 
 // ErrInvalidAuth is an error returned when there are invalid credentials
 var ErrInvalidAuth = errors.New("pkg/sponsor: invalid auth credentials")
@@ -28,6 +33,8 @@ type Sponsor struct {
 	ACL       string    `db:"acl"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
+	// DeletedAt is set when the sponsor or its company has been deleted
+	DeletedAt pq.NullTime `db:"deleted_at"`
 }
 
 // New returns a instance of a Sponsor it uses the input parameters but
@@ -52,7 +59,7 @@ func New(name, email, password, companyID string, acl string) *Sponsor {
 
 // ByID is a function that gets an admin from the given ID
 func ByID(sponsorID string) (*Sponsor, error) {
-	query := `SELECT * FROM sponsors WHERE id = :id LIMIT 1`
+	query := `SELECT * FROM sponsors WHERE id = :id AND deleted_at IS NULL LIMIT 1`
 	stmt, err := db.Conn.PrepareNamed(query)
 	if err != nil {
 		return nil, err
@@ -69,7 +76,7 @@ func ByID(sponsorID string) (*Sponsor, error) {
 
 // ByEmail is a function that gets an admin from the given email
 func ByEmail(sponsorEmail string) (*Sponsor, error) {
-	query := `SELECT * FROM sponsors WHERE email=:email`
+	query := `SELECT * FROM sponsors WHERE email=:email AND deleted_at IS NULL`
 	stmt, err := db.Conn.PrepareNamed(query)
 	if err != nil {
 		return nil, err
@@ -84,19 +91,55 @@ func ByEmail(sponsorEmail string) (*Sponsor, error) {
 	return s, nil
 }
 
+// SetPassword replaces the password of the sponsor with the hash of the plain
+// text password, the sponsor still has to be saved
+func (s *Sponsor) SetPassword(password string) error {
+	pwdHash, err := bcrypt.GenerateFromPassword([]byte(password),
+		bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	s.Password = fmt.Sprintf("%s", pwdHash)
+	return nil
+}
+
+// UpdatePassword sets a new password for the sponsor with the ID in the
+// transaction, sql.ErrNoRows is returned when there is no such sponsor
+func UpdatePassword(tx *sqlx.Tx, sponsorID, password string) error {
+	s := &Sponsor{ID: sponsorID}
+	if err := s.SetPassword(password); err != nil {
+		return err
+	}
+	var id string
+	err := tx.Get(&id, `
+	UPDATE sponsors SET password = $1
+	WHERE id = $2 AND deleted_at IS NULL
+	RETURNING id`, s.Password, s.ID)
+	if err == sql.ErrNoRows {
+		return err
+	}
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while saving password")
+	}
+	return nil
+}
+
 // Register is a function that is used when a new instance of a sponsor has to
 // be saved to the database and the in-memory instance has to be updated with
 // the lastInsertedID
 // NOTE: use this only when New Sponsors have to be created
 // Use the Save method on the Sponsor type for all other subsequent calls
 func (s *Sponsor) Register() error {
-	// hash password before storing it
-	pwdHash, err := bcrypt.GenerateFromPassword([]byte(s.Password),
-		bcrypt.DefaultCost)
-	if err != nil {
+	if err := auth.ValidateACL(s.ACL, auth.SponsorRoles); err != nil {
 		return err
 	}
-	s.Password = fmt.Sprintf("%s", pwdHash)
+	// hash password before storing it, a sponsor that is invited has no
+	// password and can not login until the invite is accepted
+	if len(s.Password) > 0 {
+		if err := s.SetPassword(s.Password); err != nil {
+			return err
+		}
+	}
 	query := `
 	INSERT INTO sponsors(name, email, password, company_id, acl)
 	VALUES(:name, :email, :password, :company, :acl) RETURNING id`
@@ -133,47 +176,79 @@ func Login(email, password string) (*Sponsor, error) {
 	return sp, nil
 }
 
-// Save is method on sponsor to save the state of a sponsor to the db
+// Save is method on sponsor to save the state of a sponsor to the db,
+// sql.ErrNoRows is returned when the sponsor does not exist or has been deleted
 func (s *Sponsor) Save() error {
+	if err := auth.ValidateACL(s.ACL, auth.SponsorRoles); err != nil {
+		return err
+	}
 	query := `
 	UPDATE sponsors
 	SET name = :name, email = :email, password = :password, acl = :acl
-	WHERE id = :id
+	WHERE id = :id AND deleted_at IS NULL
 	RETURNING id`
 	stmt, err := db.Conn.PrepareNamed(query)
 	if err != nil {
 		return err
 	}
-	_ = stmt.QueryRow(map[string]interface{}{
+	defer stmt.Close()
+	var id string
+	err = stmt.QueryRow(map[string]interface{}{
 		"id":       s.ID,
 		"name":     s.Name,
 		"email":    s.Email,
 		"password": s.Password,
 		"acl":      s.ACL,
-	})
+	}).Scan(&id)
+	if err == sql.ErrNoRows {
+		return err
+	}
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while saving sponsor")
+	}
+	return nil
+}
+
+// Delete soft deletes a sponsor, the notes of the sponsor are kept
+func (s *Sponsor) Delete() error {
+	r, err := db.Conn.NamedExec(`
+	UPDATE sponsors SET deleted_at = NOW()
+	WHERE id = :id AND deleted_at IS NULL`, s)
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while deleting sponsor")
+	}
+	if n, err := r.RowsAffected(); err != nil || n == 0 {
+		return sql.ErrNoRows
+	}
 	return nil
 }
 
 // Company is a struct that represents all the parameters required by a company
 type Company struct {
-	ID        string    `db:"id"`
-	Name      string    `db:"name"`
-	Logo      string    `db:"logo"`
+	ID   string `db:"id"`
+	Name string `db:"name"`
+	Logo string `db:"logo"`
+	// Tier is the sponsorship tier of the company, it is one of Tiers
+	Tier      string    `db:"tier"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
+	// DeletedAt is set when the company has been deleted
+	DeletedAt pq.NullTime `db:"deleted_at"`
 }
 
 // NewCompany is a constructor that returns an instance of a Company
+// NOTE: the company is in the DefaultTier
 func NewCompany(name, logo string) *Company {
 	return &Company{
 		Name: name,
 		Logo: logo,
+		Tier: DefaultTier,
 	}
 }
 
 // CompanyByID fetches a given company by the ID
 func CompanyByID(ID string) (*Company, error) {
-	query := `SELECT * FROM company WHERE id=:id LIMIT 1`
+	query := `SELECT * FROM company WHERE id=:id AND deleted_at IS NULL LIMIT 1`
 	stmt, err := db.Conn.PrepareNamed(query)
 	if err != nil {
 		return nil, err
@@ -188,29 +263,131 @@ func CompanyByID(ID string) (*Company, error) {
 	return c, nil
 }
 
-// ListCompanies fetches all the list of companies from the database
-func ListCompanies() ([]*Company, error) {
-	query := `SELECT * FROM company ORDER BY id DESC`
-	rows, err := db.Conn.Query(query)
+// ListCompanies fetches a page of companies from the database along with
+// the token for the next page
+func ListCompanies(pg pagination.Page) ([]*Company, string, error) {
+	args := map[string]interface{}{}
+	query := `SELECT id, name, logo, tier, created_at, updated_at FROM company
+	WHERE deleted_at IS NULL `
+	if cond := pg.Condition(args); len(cond) > 0 {
+		query += "AND " + cond + " "
+	}
+	query += pg.Clause(args)
+	rows, err := db.Conn.NamedQuery(query, args)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
+	defer rows.Close()
 	var companies []*Company
 	for rows.Next() {
 		c := new(Company)
-		err := rows.Scan(&c.ID, &c.Name, &c.Logo, &c.CreatedAt, &c.UpdatedAt)
+		err := rows.Scan(&c.ID, &c.Name, &c.Logo, &c.Tier, &c.CreatedAt, &c.UpdatedAt)
 		if err != nil {
-			return nil, errors.Wrap(err,
+			return nil, "", errors.Wrap(err,
 				"sponsor: error while scanning rows for companies")
 		}
 		companies = append(companies, c)
 	}
-	return companies, nil
+	n, next := pg.Next(len(companies), func(i int) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: companies[i].CreatedAt, ID: companies[i].ID}
+	})
+	return companies[:n], next, nil
+}
+
+// Update saves the name and the logo of an existing company to the database
+func (c *Company) Update() error {
+	r, err := db.Conn.NamedExec(`
+	UPDATE company SET name = :name, logo = :logo, updated_at = NOW()
+	WHERE id = :id AND deleted_at IS NULL`, c)
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while updating company")
+	}
+	if n, err := r.RowsAffected(); err != nil || n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ListSponsors fetches a page of the sponsors of a company along with the
+// token for the next page
+func ListSponsors(companyID string, pg pagination.Page) ([]*Sponsor, string, error) {
+	args := map[string]interface{}{
+		"company_id": companyID,
+	}
+	query := `SELECT id, name, email, company_id, acl, created_at, updated_at FROM sponsors
+	WHERE company_id = :company_id AND deleted_at IS NULL `
+	if cond := pg.Condition(args); len(cond) > 0 {
+		query += "AND " + cond + " "
+	}
+	query += pg.Clause(args)
+	rows, err := db.Conn.NamedQuery(query, args)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	var sponsors []*Sponsor
+	for rows.Next() {
+		s := new(Sponsor)
+		err := rows.Scan(&s.ID, &s.Name, &s.Email, &s.CompanyID, &s.ACL,
+			&s.CreatedAt, &s.UpdatedAt)
+		if err != nil {
+			return nil, "", errors.Wrap(err,
+				"sponsor: error while scanning rows for sponsors")
+		}
+		sponsors = append(sponsors, s)
+	}
+	n, next := pg.Next(len(sponsors), func(i int) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: sponsors[i].CreatedAt, ID: sponsors[i].ID}
+	})
+	return sponsors[:n], next, nil
+}
+
+// Delete soft deletes a company along with all of its sponsors so that none
+// of them can login anymore
+func (c *Company) Delete() error {
+	tx, err := db.Conn.Beginx()
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while starting transaction")
+	}
+	defer tx.Rollback()
+	r, err := tx.NamedExec(`
+	UPDATE company SET deleted_at = NOW()
+	WHERE id = :id AND deleted_at IS NULL`, c)
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while deleting company")
+	}
+	if n, err := r.RowsAffected(); err != nil || n == 0 {
+		return sql.ErrNoRows
+	}
+	_, err = tx.NamedExec(`
+	UPDATE sponsors SET deleted_at = NOW()
+	WHERE company_id = :id AND deleted_at IS NULL`, c)
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while deleting sponsors of company")
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while committing delete")
+	}
+	return nil
+}
+
+// SponsorIDs returns the IDs of all the sponsors of the company including the
+// ones that have been deleted
+func (c *Company) SponsorIDs() ([]string, error) {
+	var ids []string
+	err := db.Conn.Select(&ids, `SELECT id FROM sponsors WHERE company_id = $1`, c.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "pkg/sponsor: error while listing sponsors of company")
+	}
+	return ids, nil
 }
 
 // Save saves an instance of the company to the database
 func (c *Company) Save() error {
-	q := `INSERT INTO company(name, logo) VALUES(:name, :logo) RETURNING id`
+	if _, ok := Tiers[c.Tier]; !ok {
+		return ErrUnknownTier
+	}
+	q := `INSERT INTO company(name, logo, tier) VALUES(:name, :logo, :tier) RETURNING id`
 	stmt, err := db.Conn.PrepareNamed(q)
 	if err != nil {
 		return err
@@ -0,0 +1,197 @@
+package sponsor
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"github.com/auburnhacks/sponsor/pkg/db"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// DefaultTier is the tier of a company that is created without one
+var DefaultTier = "bronze"
+
+// EventStart is the time the hackathon starts, sponsors without pre-event
+// access cannot see any participant before it. A zero value disables the
+// check
+var EventStart time.Time
+
+var (
+	// ErrUnknownTier is returned when a company is given a tier that is not
+	// in Tiers
+	ErrUnknownTier = errors.New("pkg/sponsor: unknown sponsorship tier")
+	// ErrNoPreEventAccess is returned when a sponsor without pre-event
+	// access asks for participants before the event
+	ErrNoPreEventAccess = errors.New("pkg/sponsor: tier does not include access before the event")
+	// ErrNoResumeAccess is returned when a sponsor without resume access asks
+	// for resumes
+	ErrNoResumeAccess = errors.New("pkg/sponsor: tier does not include resume access")
+	// ErrNoNotesAccess is returned when a sponsor without notes uses notes
+	ErrNoNotesAccess = errors.New("pkg/sponsor: tier does not include notes")
+	// ErrResumeLimit is returned when a company asks for more resumes than
+	// its tier allows
+	ErrResumeLimit = errors.New("pkg/sponsor: resume download limit of tier reached")
+)
+
+// Entitlements describe what the sponsors of a company are allowed to do
+type Entitlements struct {
+	// ResumeAccess allows the sponsors to view and download resumes
+	ResumeAccess bool `json:"resume_access"`
+	// PreEventAccess allows the sponsors to see participants before
+	// EventStart
+	PreEventAccess bool `json:"pre_event_access"`
+	// MaxResumes is the number of different resumes the sponsors of a company
+	// can download in total, a zero value means there is no limit
+	MaxResumes int `json:"max_resumes"`
+	// Notes allows the sponsors to write notes about participants
+	Notes bool `json:"notes"`
+}
+
+// Tiers maps the name of every sponsorship tier to its entitlements, it can
+// be replaced with LoadTiers
+var Tiers = map[string]Entitlements{
+	"bronze": {ResumeAccess: true, MaxResumes: 50},
+	"silver": {ResumeAccess: true, MaxResumes: 200, Notes: true},
+	"gold":   {ResumeAccess: true, PreEventAccess: true, Notes: true},
+}
+
+// LoadTiers replaces Tiers with the tiers in a JSON file that maps the name of
+// every tier to its entitlements e.g. {"gold": {"resume_access": true}}
+func LoadTiers(path string) error {
+	bb, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while reading tiers")
+	}
+	tiers := map[string]Entitlements{}
+	if err := json.Unmarshal(bb, &tiers); err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while decoding tiers")
+	}
+	if _, ok := tiers[DefaultTier]; !ok {
+		return errors.Errorf("pkg/sponsor: default tier %s is missing", DefaultTier)
+	}
+	Tiers = tiers
+	return nil
+}
+
+// Entitlements returns the entitlements of the tier of the company, a company
+// with a tier that is no longer configured is not entitled to anything
+func (c *Company) Entitlements() Entitlements {
+	return Tiers[c.Tier]
+}
+
+// CanViewParticipants returns an error when the participants cannot be seen
+// at the given time
+func (e Entitlements) CanViewParticipants(now time.Time) error {
+	if !e.PreEventAccess && now.Before(EventStart) {
+		return ErrNoPreEventAccess
+	}
+	return nil
+}
+
+// CanViewResumes returns an error when the resumes cannot be viewed at the
+// given time
+func (e Entitlements) CanViewResumes(now time.Time) error {
+	if err := e.CanViewParticipants(now); err != nil {
+		return err
+	}
+	if !e.ResumeAccess {
+		return ErrNoResumeAccess
+	}
+	return nil
+}
+
+// CanTakeNotes returns an error when notes are not included
+func (e Entitlements) CanTakeNotes() error {
+	if !e.Notes {
+		return ErrNoNotesAccess
+	}
+	return nil
+}
+
+// SetTier changes the tier of a company
+func (c *Company) SetTier(tier string) error {
+	if _, ok := Tiers[tier]; !ok {
+		return ErrUnknownTier
+	}
+	r, err := db.Conn.Exec(`
+	UPDATE company SET tier = $1, updated_at = NOW()
+	WHERE id = $2 AND deleted_at IS NULL`, tier, c.ID)
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while changing tier")
+	}
+	if n, err := r.RowsAffected(); err != nil || n == 0 {
+		return errors.New("pkg/sponsor: company not found")
+	}
+	c.Tier = tier
+	return nil
+}
+
+// participantKey is the key of a participant in company_resume_downloads, the
+// external ID survives a sync while the ID does not
+const participantKey = `COALESCE(p.external_id, p.id::text)`
+
+// ReserveResumes records that the company downloads the resumes of the given
+// participants and returns the participants that had not been downloaded
+// before. It fails without recording anything when those resumes would exceed
+// the limit of the tier
+func (c *Company) ReserveResumes(participantIDs []string) ([]string, error) {
+	max := c.Entitlements().MaxResumes
+	tx, err := db.Conn.Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "pkg/sponsor: error while starting transaction")
+	}
+	defer tx.Rollback()
+	// the company is locked so that concurrent downloads cannot both pass
+	// the limit
+	if _, err := tx.Exec(`SELECT id FROM company WHERE id = $1 FOR UPDATE`, c.ID); err != nil {
+		return nil, errors.Wrap(err, "pkg/sponsor: error while locking company")
+	}
+	var reserved []string
+	err = tx.Select(&reserved, `
+	WITH p AS (
+		SELECT p.id, `+participantKey+` AS key FROM participants p WHERE p.id::text = ANY($2)
+	), ins AS (
+		INSERT INTO company_resume_downloads (company_id, participant_key)
+		SELECT $1, key FROM p
+		ON CONFLICT (company_id, participant_key) DO NOTHING
+		RETURNING participant_key
+	)
+	SELECT p.id FROM p JOIN ins ON ins.participant_key = p.key`, c.ID, pq.Array(participantIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "pkg/sponsor: error while reserving resumes")
+	}
+	if max > 0 {
+		var total int
+		err := tx.Get(&total, `
+		SELECT COUNT(*) FROM company_resume_downloads WHERE company_id = $1`, c.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "pkg/sponsor: error while counting resumes")
+		}
+		if total > max {
+			return nil, ErrResumeLimit
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "pkg/sponsor: error while committing resumes")
+	}
+	return reserved, nil
+}
+
+// ReleaseResumes gives back the resumes of the participants that were
+// reserved but could not be downloaded so that they do not count towards the
+// limit of the tier
+func (c *Company) ReleaseResumes(participantIDs []string) error {
+	if len(participantIDs) == 0 {
+		return nil
+	}
+	_, err := db.Conn.Exec(`
+	DELETE FROM company_resume_downloads d USING participants p
+	WHERE d.company_id = $1 AND p.id::text = ANY($2)
+	AND d.participant_key = `+participantKey, c.ID, pq.Array(participantIDs))
+	if err != nil {
+		return errors.Wrap(err, "pkg/sponsor: error while releasing resumes")
+	}
+	return nil
+}
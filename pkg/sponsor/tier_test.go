@@ -0,0 +1,29 @@
+package sponsor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEntitlements(t *testing.T) {
+	defer func(start time.Time) { EventStart = start }(EventStart)
+	EventStart = time.Date(2019, 2, 23, 9, 0, 0, 0, time.UTC)
+	before, after := EventStart.Add(-time.Hour), EventStart.Add(time.Hour)
+
+	bronze := Entitlements{ResumeAccess: true, MaxResumes: 50}
+	if err := bronze.CanViewParticipants(before); err != ErrNoPreEventAccess {
+		t.Fatalf("expected no pre-event access got: %v", err)
+	}
+	if err := bronze.CanViewResumes(after); err != nil {
+		t.Fatalf("expected resume access got: %v", err)
+	}
+	if err := bronze.CanTakeNotes(); err != ErrNoNotesAccess {
+		t.Fatalf("expected no notes got: %v", err)
+	}
+	if err := (Entitlements{PreEventAccess: true}).CanViewResumes(before); err != ErrNoResumeAccess {
+		t.Fatalf("expected no resume access got: %v", err)
+	}
+	if err := (&Company{Tier: "unknown"}).Entitlements().CanViewResumes(after); err == nil {
+		t.Fatal("expected an unknown tier to have no access")
+	}
+}
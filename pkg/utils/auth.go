@@ -8,6 +8,8 @@ import (
 
 	"github.com/auburnhacks/sponsor/pkg/auth"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -18,11 +20,23 @@ var (
 )
 
 // authenticate is a helper function that the invoked by the
-// gRPC interceptors to authenticate RPC requests
-func authenticate(ctx context.Context) error {
-	_, err := auth.FromContext(ctx)
+// gRPC interceptors to authenticate and authorize RPC requests. The principal
+// making an authenticated request is embedded in the returned context.
+// A missing, expired or revoked token is returned as codes.Unauthenticated so
+// that the gateway answers with a 401 and the client can refresh its token
+func authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	if err := isUnauthenticatedRPC(fullMethod); err == nil {
+		return ctx, nil
+	}
+	if isBootstrap(fullMethod) {
+		return ctx, nil
+	}
+	cl, err := auth.FromContext(ctx)
 	if err != nil {
-		return err
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err := authorize(fullMethod, cl); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
 	}
-	return nil
+	return auth.WithPrincipal(ctx, cl.Principal()), nil
 }
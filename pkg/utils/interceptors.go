@@ -20,25 +20,81 @@ var unauthenticatedRPC = map[string][]string{
 	"proto.SponsorService": []string{
 		"LoginAdmin",
 		"LoginSponsor",
-		"CreateAdmin",
-		"ListCompanines",
+		"RefreshToken",
+		"AcceptInvite",
+		"RequestPasswordReset",
+		"ResetPassword",
 	},
 }
 
 // UnaryAuthInterceptor is a gRPC middleware that intercepts all
-// unary RPC calls and check whether they are authenticated
+// unary RPC calls and check whether they are authenticated and whether the
+// roles of the user allow the call
 func UnaryAuthInterceptor(ctx context.Context,
 	req interface{}, info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler) (interface{}, error) {
 	start := time.Now()
 
-	// no authorization required for LoginAdmin RPC call
-	if err := isUnauthenticatedRPC(info.FullMethod); err != nil {
-		if err := authenticate(ctx); err != nil {
-			return nil, err
-		}
+	ctx, err := authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = withRequestLogger(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+	logger := log.GetLogger(ctx)
+
+	h, err := handler(ctx, req)
+	if err != nil {
+		logger.Errorf("%s took %d", info.FullMethod, time.Since(start))
+	} else {
+		logger.Infof("%s took %d", info.FullMethod, time.Since(start))
+	}
+	return h, err
+}
+
+// StreamAuthInterceptor is a gRPC middleware that intercepts all
+// streaming RPC calls and check whether they are authenticated and whether
+// the roles of the user allow the call
+func StreamAuthInterceptor(srv interface{}, ss grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	ctx := ss.Context()
+
+	ctx, err := authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return err
+	}
+	ctx, err = withRequestLogger(ctx, start)
+	if err != nil {
+		return err
 	}
-	// update the context with the logger
+	logger := log.GetLogger(ctx)
+
+	err = handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	if err != nil {
+		logger.Errorf("%s took %d", info.FullMethod, time.Since(start))
+	} else {
+		logger.Infof("%s took %d", info.FullMethod, time.Since(start))
+	}
+	return err
+}
+
+// serverStream is a grpc.ServerStream with a context that has the logger
+// of the request embedded in it
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// withRequestLogger embeds a logger with the request id and origin of
+// the request in the context and sets the X-Request-ID header
+func withRequestLogger(ctx context.Context, start time.Time) (context.Context, error) {
 	uuid, err := uuid.NewUUID()
 	if err != nil {
 		return nil, err
@@ -56,15 +112,7 @@ func UnaryAuthInterceptor(ctx context.Context,
 	}
 	ctx = log.WithFields(ctx, fields)
 	grpc.SetHeader(ctx, reqIDHeader)
-	logger := log.GetLogger(ctx)
-
-	h, err := handler(ctx, req)
-	if err != nil {
-		logger.Errorf("%s took %d", info.FullMethod, time.Since(start))
-	} else {
-		logger.Infof("%s took %d", info.FullMethod, time.Since(start))
-	}
-	return h, err
+	return ctx, nil
 }
 
 func isUnauthenticatedRPC(fullMethod string) error {
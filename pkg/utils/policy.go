@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"github.com/auburnhacks/sponsor/pkg/admin"
+	"github.com/auburnhacks/sponsor/pkg/auth"
+	"github.com/pkg/errors"
+)
+
+// ErrForbidden is sent to the user when none of their roles grants a
+// permission of the RPC call
+var ErrForbidden = errors.New("auth: user is not allowed to make this RPC call")
+
+// bootstrapRPC is the RPC call that can be made without authentication as
+// long as there are no admins, it creates the first admin
+const bootstrapRPC = "/proto.SponsorService/CreateAdmin"
+
+// rpcPermissions maps every authenticated RPC call to the permissions that
+// allow it, a user needs any one of them. The handlers still check that the
+// data belongs to the company or the user making the request
+// NOTE: an RPC call that is missing from this table can not be made by anyone
+var rpcPermissions = map[string][]auth.Permission{
+	"/proto.SponsorService/CreateAdmin":         {auth.PermAdminsWrite},
+	"/proto.SponsorService/GetAdmin":            {auth.PermProfileRead},
+	"/proto.SponsorService/DeleteAdmin":         {auth.PermAdminsWrite},
+	"/proto.SponsorService/UpdateAdmin":         {auth.PermAdminsWrite},
+	"/proto.SponsorService/GetSyncStatus":       {auth.PermSyncRead},
+	"/proto.SponsorService/TriggerSync":         {auth.PermSyncWrite},
+	"/proto.SponsorService/CreateSponsor":       {auth.PermSponsorsWrite, auth.PermCompanyManage},
+	"/proto.SponsorService/GetSponsor":          {auth.PermProfileRead},
+	"/proto.SponsorService/UpdateSponsor":       {auth.PermSponsorsWrite, auth.PermCompanyManage},
+	"/proto.SponsorService/DeleteSponsor":       {auth.PermSponsorsWrite},
+	"/proto.SponsorService/CreateCompany":       {auth.PermCompaniesWrite},
+	"/proto.SponsorService/GetCompany":          {auth.PermCompaniesRead},
+	"/proto.SponsorService/UpdateCompany":       {auth.PermCompaniesWrite, auth.PermCompanyManage},
+	"/proto.SponsorService/ListCompanySponsors": {auth.PermSponsorsRead, auth.PermCompaniesRead},
+	"/proto.SponsorService/SetCompanyTier":      {auth.PermCompaniesWrite},
+	"/proto.SponsorService/DeleteCompany":       {auth.PermCompaniesWrite},
+	"/proto.SponsorService/Resumes":             {auth.PermResumesRead},
+	"/proto.SponsorService/StreamResumes":       {auth.PermResumesRead},
+	"/proto.SponsorService/GetResume":           {auth.PermResumesRead},
+	"/proto.SponsorService/AddToShortlist":      {auth.PermShortlistWrite},
+	"/proto.SponsorService/RemoveFromShortlist": {auth.PermShortlistWrite},
+	"/proto.SponsorService/ListShortlist":       {auth.PermShortlistRead},
+	"/proto.SponsorService/CreateNote":          {auth.PermNotesWrite},
+	"/proto.SponsorService/ListNotes":           {auth.PermNotesRead},
+	"/proto.SponsorService/UpdateNote":          {auth.PermNotesWrite},
+	"/proto.SponsorService/DeleteNote":          {auth.PermNotesWrite},
+	"/proto.SponsorService/ListParticipants":    {auth.PermParticipantsRead},
+	"/proto.SponsorService/SearchParticipants":  {auth.PermParticipantsRead},
+	"/proto.SponsorService/ListCompanies":       {auth.PermCompaniesRead},
+	"/proto.SponsorService/Logout":              {auth.PermProfileRead},
+}
+
+// authorize returns ErrForbidden when the claims do not grant any of the
+// permissions of the RPC call
+func authorize(fullMethod string, cl auth.Claims) error {
+	perms, ok := rpcPermissions[fullMethod]
+	if !ok {
+		return ErrForbidden
+	}
+	for _, p := range perms {
+		if err := cl.Claim(p); err == nil {
+			return nil
+		}
+	}
+	return ErrForbidden
+}
+
+// isBootstrap reports whether the RPC call creates the first admin. The count
+// can be stale by the time the admin is created, CreateAdmin checks it again
+// with admin.RegisterFirst under a lock
+func isBootstrap(fullMethod string) bool {
+	if fullMethod != bootstrapRPC {
+		return false
+	}
+	n, err := admin.Count()
+	return err == nil && n == 0
+}
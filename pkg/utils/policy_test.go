@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/auburnhacks/sponsor/pkg/auth"
+	api "github.com/auburnhacks/sponsor/proto"
+)
+
+func TestEveryRPCHasAPolicy(t *testing.T) {
+	srv := reflect.TypeOf((*api.SponsorServiceServer)(nil)).Elem()
+	for i := 0; i < srv.NumMethod(); i++ {
+		fullMethod := "/proto.SponsorService/" + srv.Method(i).Name
+		_, ok := rpcPermissions[fullMethod]
+		if !ok && isUnauthenticatedRPC(fullMethod) != nil {
+			t.Errorf("%s has no permissions", fullMethod)
+		}
+	}
+}
+
+func TestSponsorsCannotMakeAdminRPCCalls(t *testing.T) {
+	adminOnly := []string{
+		"CreateAdmin", "UpdateAdmin", "DeleteAdmin", "DeleteSponsor",
+		"CreateCompany", "DeleteCompany", "SetCompanyTier", "TriggerSync",
+		"GetSyncStatus",
+	}
+	for _, role := range auth.SponsorRoles {
+		cl := auth.NewSponsor("sponsor-1", "company-1", string(role), "gold")
+		for _, rpc := range adminOnly {
+			if err := authorize("/proto.SponsorService/"+rpc, cl); err != ErrForbidden {
+				t.Errorf("%s was allowed to call %s", role, rpc)
+			}
+		}
+	}
+	cl := auth.NewAdmin("admin-1", string(auth.RoleSuperAdmin))
+	for _, rpc := range adminOnly {
+		if err := authorize("/proto.SponsorService/"+rpc, cl); err != nil {
+			t.Errorf("super admin was not allowed to call %s: %v", rpc, err)
+		}
+	}
+}
+
+func TestUnknownRPCIsForbidden(t *testing.T) {
+	cl := auth.NewAdmin("admin-1", string(auth.RoleSuperAdmin))
+	if err := authorize("/proto.SponsorService/FooBar", cl); err != ErrForbidden {
+		t.Fatalf("expected forbidden got: %v", err)
+	}
+}
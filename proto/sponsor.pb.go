@@ -6,6 +6,7 @@ package proto
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	context "golang.org/x/net/context"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -23,1892 +24,5299 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
-type GetSponsorRequest struct {
-	SponsorId            string   `protobuf:"bytes,1,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`
+type GetSyncStatusRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSponsorRequest) Reset()         { *m = GetSponsorRequest{} }
-func (m *GetSponsorRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSponsorRequest) ProtoMessage()    {}
-func (*GetSponsorRequest) Descriptor() ([]byte, []int) {
+func (m *GetSyncStatusRequest) Reset()         { *m = GetSyncStatusRequest{} }
+func (m *GetSyncStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSyncStatusRequest) ProtoMessage()    {}
+func (*GetSyncStatusRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{0}
 }
 
-func (m *GetSponsorRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSponsorRequest.Unmarshal(m, b)
+func (m *GetSyncStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSyncStatusRequest.Unmarshal(m, b)
 }
-func (m *GetSponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSponsorRequest.Marshal(b, m, deterministic)
+func (m *GetSyncStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSyncStatusRequest.Marshal(b, m, deterministic)
 }
-func (m *GetSponsorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSponsorRequest.Merge(m, src)
+func (m *GetSyncStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSyncStatusRequest.Merge(m, src)
 }
-func (m *GetSponsorRequest) XXX_Size() int {
-	return xxx_messageInfo_GetSponsorRequest.Size(m)
+func (m *GetSyncStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSyncStatusRequest.Size(m)
 }
-func (m *GetSponsorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSponsorRequest.DiscardUnknown(m)
+func (m *GetSyncStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSyncStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSponsorRequest proto.InternalMessageInfo
-
-func (m *GetSponsorRequest) GetSponsorId() string {
-	if m != nil {
-		return m.SponsorId
-	}
-	return ""
-}
+var xxx_messageInfo_GetSyncStatusRequest proto.InternalMessageInfo
 
-type GetSponsorResponse struct {
-	Sponsor              *Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetSyncStatusResponse struct {
+	Status               *SyncStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *GetSponsorResponse) Reset()         { *m = GetSponsorResponse{} }
-func (m *GetSponsorResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSponsorResponse) ProtoMessage()    {}
-func (*GetSponsorResponse) Descriptor() ([]byte, []int) {
+func (m *GetSyncStatusResponse) Reset()         { *m = GetSyncStatusResponse{} }
+func (m *GetSyncStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSyncStatusResponse) ProtoMessage()    {}
+func (*GetSyncStatusResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{1}
 }
 
-func (m *GetSponsorResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSponsorResponse.Unmarshal(m, b)
+func (m *GetSyncStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSyncStatusResponse.Unmarshal(m, b)
 }
-func (m *GetSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSponsorResponse.Marshal(b, m, deterministic)
+func (m *GetSyncStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSyncStatusResponse.Marshal(b, m, deterministic)
 }
-func (m *GetSponsorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSponsorResponse.Merge(m, src)
+func (m *GetSyncStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSyncStatusResponse.Merge(m, src)
 }
-func (m *GetSponsorResponse) XXX_Size() int {
-	return xxx_messageInfo_GetSponsorResponse.Size(m)
+func (m *GetSyncStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSyncStatusResponse.Size(m)
 }
-func (m *GetSponsorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSponsorResponse.DiscardUnknown(m)
+func (m *GetSyncStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSyncStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSponsorResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetSyncStatusResponse proto.InternalMessageInfo
 
-func (m *GetSponsorResponse) GetSponsor() *Sponsor {
+func (m *GetSyncStatusResponse) GetStatus() *SyncStatus {
 	if m != nil {
-		return m.Sponsor
+		return m.Status
 	}
 	return nil
 }
 
-type ResumesRequest struct {
-	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+type TriggerSyncRequest struct {
+	// full fetches every participant and deletes the ones that are missing
+	// from the source instead of only fetching the updated participants
+	Full                 bool     `protobuf:"varint,1,opt,name=full,proto3" json:"full,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResumesRequest) Reset()         { *m = ResumesRequest{} }
-func (m *ResumesRequest) String() string { return proto.CompactTextString(m) }
-func (*ResumesRequest) ProtoMessage()    {}
-func (*ResumesRequest) Descriptor() ([]byte, []int) {
+func (m *TriggerSyncRequest) Reset()         { *m = TriggerSyncRequest{} }
+func (m *TriggerSyncRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggerSyncRequest) ProtoMessage()    {}
+func (*TriggerSyncRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{2}
 }
 
-func (m *ResumesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResumesRequest.Unmarshal(m, b)
+func (m *TriggerSyncRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerSyncRequest.Unmarshal(m, b)
 }
-func (m *ResumesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResumesRequest.Marshal(b, m, deterministic)
+func (m *TriggerSyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerSyncRequest.Marshal(b, m, deterministic)
 }
-func (m *ResumesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResumesRequest.Merge(m, src)
+func (m *TriggerSyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerSyncRequest.Merge(m, src)
 }
-func (m *ResumesRequest) XXX_Size() int {
-	return xxx_messageInfo_ResumesRequest.Size(m)
+func (m *TriggerSyncRequest) XXX_Size() int {
+	return xxx_messageInfo_TriggerSyncRequest.Size(m)
 }
-func (m *ResumesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResumesRequest.DiscardUnknown(m)
+func (m *TriggerSyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerSyncRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResumesRequest proto.InternalMessageInfo
+var xxx_messageInfo_TriggerSyncRequest proto.InternalMessageInfo
 
-func (m *ResumesRequest) GetLimit() int32 {
+func (m *TriggerSyncRequest) GetFull() bool {
 	if m != nil {
-		return m.Limit
+		return m.Full
 	}
-	return 0
+	return false
 }
 
-type ResumesResponse struct {
-	Archive              []byte   `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+type TriggerSyncResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResumesResponse) Reset()         { *m = ResumesResponse{} }
-func (m *ResumesResponse) String() string { return proto.CompactTextString(m) }
-func (*ResumesResponse) ProtoMessage()    {}
-func (*ResumesResponse) Descriptor() ([]byte, []int) {
+func (m *TriggerSyncResponse) Reset()         { *m = TriggerSyncResponse{} }
+func (m *TriggerSyncResponse) String() string { return proto.CompactTextString(m) }
+func (*TriggerSyncResponse) ProtoMessage()    {}
+func (*TriggerSyncResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{3}
 }
 
-func (m *ResumesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ResumesResponse.Unmarshal(m, b)
+func (m *TriggerSyncResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerSyncResponse.Unmarshal(m, b)
 }
-func (m *ResumesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ResumesResponse.Marshal(b, m, deterministic)
+func (m *TriggerSyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerSyncResponse.Marshal(b, m, deterministic)
 }
-func (m *ResumesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResumesResponse.Merge(m, src)
+func (m *TriggerSyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerSyncResponse.Merge(m, src)
 }
-func (m *ResumesResponse) XXX_Size() int {
-	return xxx_messageInfo_ResumesResponse.Size(m)
+func (m *TriggerSyncResponse) XXX_Size() int {
+	return xxx_messageInfo_TriggerSyncResponse.Size(m)
 }
-func (m *ResumesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResumesResponse.DiscardUnknown(m)
+func (m *TriggerSyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerSyncResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResumesResponse proto.InternalMessageInfo
+var xxx_messageInfo_TriggerSyncResponse proto.InternalMessageInfo
 
-func (m *ResumesResponse) GetArchive() []byte {
+func (m *TriggerSyncResponse) GetOk() bool {
 	if m != nil {
-		return m.Archive
+		return m.Ok
 	}
-	return nil
+	return false
 }
 
-type ListCompaniesResponse struct {
-	Companies            []*Company `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type AddToShortlistRequest struct {
+	ParticipantId        string   `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListCompaniesResponse) Reset()         { *m = ListCompaniesResponse{} }
-func (m *ListCompaniesResponse) String() string { return proto.CompactTextString(m) }
-func (*ListCompaniesResponse) ProtoMessage()    {}
-func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
+func (m *AddToShortlistRequest) Reset()         { *m = AddToShortlistRequest{} }
+func (m *AddToShortlistRequest) String() string { return proto.CompactTextString(m) }
+func (*AddToShortlistRequest) ProtoMessage()    {}
+func (*AddToShortlistRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{4}
 }
 
-func (m *ListCompaniesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListCompaniesResponse.Unmarshal(m, b)
+func (m *AddToShortlistRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddToShortlistRequest.Unmarshal(m, b)
 }
-func (m *ListCompaniesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListCompaniesResponse.Marshal(b, m, deterministic)
+func (m *AddToShortlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddToShortlistRequest.Marshal(b, m, deterministic)
 }
-func (m *ListCompaniesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListCompaniesResponse.Merge(m, src)
+func (m *AddToShortlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToShortlistRequest.Merge(m, src)
 }
-func (m *ListCompaniesResponse) XXX_Size() int {
-	return xxx_messageInfo_ListCompaniesResponse.Size(m)
+func (m *AddToShortlistRequest) XXX_Size() int {
+	return xxx_messageInfo_AddToShortlistRequest.Size(m)
 }
-func (m *ListCompaniesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListCompaniesResponse.DiscardUnknown(m)
+func (m *AddToShortlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToShortlistRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListCompaniesResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddToShortlistRequest proto.InternalMessageInfo
 
-func (m *ListCompaniesResponse) GetCompanies() []*Company {
+func (m *AddToShortlistRequest) GetParticipantId() string {
 	if m != nil {
-		return m.Companies
+		return m.ParticipantId
 	}
-	return nil
+	return ""
 }
 
-type ListCompaniesRequest struct {
-	// limit is an optional field
-	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+type AddToShortlistResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListCompaniesRequest) Reset()         { *m = ListCompaniesRequest{} }
-func (m *ListCompaniesRequest) String() string { return proto.CompactTextString(m) }
-func (*ListCompaniesRequest) ProtoMessage()    {}
-func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
+func (m *AddToShortlistResponse) Reset()         { *m = AddToShortlistResponse{} }
+func (m *AddToShortlistResponse) String() string { return proto.CompactTextString(m) }
+func (*AddToShortlistResponse) ProtoMessage()    {}
+func (*AddToShortlistResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{5}
 }
 
-func (m *ListCompaniesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListCompaniesRequest.Unmarshal(m, b)
+func (m *AddToShortlistResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddToShortlistResponse.Unmarshal(m, b)
 }
-func (m *ListCompaniesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListCompaniesRequest.Marshal(b, m, deterministic)
+func (m *AddToShortlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddToShortlistResponse.Marshal(b, m, deterministic)
 }
-func (m *ListCompaniesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListCompaniesRequest.Merge(m, src)
+func (m *AddToShortlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToShortlistResponse.Merge(m, src)
 }
-func (m *ListCompaniesRequest) XXX_Size() int {
-	return xxx_messageInfo_ListCompaniesRequest.Size(m)
+func (m *AddToShortlistResponse) XXX_Size() int {
+	return xxx_messageInfo_AddToShortlistResponse.Size(m)
 }
-func (m *ListCompaniesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListCompaniesRequest.DiscardUnknown(m)
+func (m *AddToShortlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToShortlistResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListCompaniesRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddToShortlistResponse proto.InternalMessageInfo
 
-func (m *ListCompaniesRequest) GetLimit() int32 {
+func (m *AddToShortlistResponse) GetOk() bool {
 	if m != nil {
-		return m.Limit
+		return m.Ok
 	}
-	return 0
+	return false
 }
 
-type LoginSponsorRequest struct {
-	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	PasswordPlainText    string   `protobuf:"bytes,2,opt,name=password_plain_text,json=passwordPlainText,proto3" json:"password_plain_text,omitempty"`
+type RemoveFromShortlistRequest struct {
+	ParticipantId        string   `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoginSponsorRequest) Reset()         { *m = LoginSponsorRequest{} }
-func (m *LoginSponsorRequest) String() string { return proto.CompactTextString(m) }
-func (*LoginSponsorRequest) ProtoMessage()    {}
-func (*LoginSponsorRequest) Descriptor() ([]byte, []int) {
+func (m *RemoveFromShortlistRequest) Reset()         { *m = RemoveFromShortlistRequest{} }
+func (m *RemoveFromShortlistRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromShortlistRequest) ProtoMessage()    {}
+func (*RemoveFromShortlistRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{6}
 }
 
-func (m *LoginSponsorRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoginSponsorRequest.Unmarshal(m, b)
+func (m *RemoveFromShortlistRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveFromShortlistRequest.Unmarshal(m, b)
 }
-func (m *LoginSponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoginSponsorRequest.Marshal(b, m, deterministic)
+func (m *RemoveFromShortlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveFromShortlistRequest.Marshal(b, m, deterministic)
 }
-func (m *LoginSponsorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoginSponsorRequest.Merge(m, src)
+func (m *RemoveFromShortlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromShortlistRequest.Merge(m, src)
 }
-func (m *LoginSponsorRequest) XXX_Size() int {
-	return xxx_messageInfo_LoginSponsorRequest.Size(m)
+func (m *RemoveFromShortlistRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveFromShortlistRequest.Size(m)
 }
-func (m *LoginSponsorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoginSponsorRequest.DiscardUnknown(m)
+func (m *RemoveFromShortlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromShortlistRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoginSponsorRequest proto.InternalMessageInfo
-
-func (m *LoginSponsorRequest) GetEmail() string {
-	if m != nil {
-		return m.Email
-	}
-	return ""
-}
+var xxx_messageInfo_RemoveFromShortlistRequest proto.InternalMessageInfo
 
-func (m *LoginSponsorRequest) GetPasswordPlainText() string {
+func (m *RemoveFromShortlistRequest) GetParticipantId() string {
 	if m != nil {
-		return m.PasswordPlainText
+		return m.ParticipantId
 	}
 	return ""
 }
 
-type LoginSponsorResponse struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Sponsor              *Sponsor `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+type RemoveFromShortlistResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoginSponsorResponse) Reset()         { *m = LoginSponsorResponse{} }
-func (m *LoginSponsorResponse) String() string { return proto.CompactTextString(m) }
-func (*LoginSponsorResponse) ProtoMessage()    {}
-func (*LoginSponsorResponse) Descriptor() ([]byte, []int) {
+func (m *RemoveFromShortlistResponse) Reset()         { *m = RemoveFromShortlistResponse{} }
+func (m *RemoveFromShortlistResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveFromShortlistResponse) ProtoMessage()    {}
+func (*RemoveFromShortlistResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{7}
 }
 
-func (m *LoginSponsorResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoginSponsorResponse.Unmarshal(m, b)
+func (m *RemoveFromShortlistResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveFromShortlistResponse.Unmarshal(m, b)
 }
-func (m *LoginSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoginSponsorResponse.Marshal(b, m, deterministic)
+func (m *RemoveFromShortlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveFromShortlistResponse.Marshal(b, m, deterministic)
 }
-func (m *LoginSponsorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoginSponsorResponse.Merge(m, src)
+func (m *RemoveFromShortlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveFromShortlistResponse.Merge(m, src)
 }
-func (m *LoginSponsorResponse) XXX_Size() int {
-	return xxx_messageInfo_LoginSponsorResponse.Size(m)
+func (m *RemoveFromShortlistResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveFromShortlistResponse.Size(m)
 }
-func (m *LoginSponsorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoginSponsorResponse.DiscardUnknown(m)
+func (m *RemoveFromShortlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveFromShortlistResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoginSponsorResponse proto.InternalMessageInfo
-
-func (m *LoginSponsorResponse) GetToken() string {
-	if m != nil {
-		return m.Token
-	}
-	return ""
-}
+var xxx_messageInfo_RemoveFromShortlistResponse proto.InternalMessageInfo
 
-func (m *LoginSponsorResponse) GetSponsor() *Sponsor {
+func (m *RemoveFromShortlistResponse) GetOk() bool {
 	if m != nil {
-		return m.Sponsor
+		return m.Ok
 	}
-	return nil
+	return false
 }
 
-type ListParticipantsRequest struct {
-	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+type ListShortlistRequest struct {
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// page_token is the next_page_token of a previous response
+	PageToken            string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListParticipantsRequest) Reset()         { *m = ListParticipantsRequest{} }
-func (m *ListParticipantsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListParticipantsRequest) ProtoMessage()    {}
-func (*ListParticipantsRequest) Descriptor() ([]byte, []int) {
+func (m *ListShortlistRequest) Reset()         { *m = ListShortlistRequest{} }
+func (m *ListShortlistRequest) String() string { return proto.CompactTextString(m) }
+func (*ListShortlistRequest) ProtoMessage()    {}
+func (*ListShortlistRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{8}
 }
 
-func (m *ListParticipantsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListParticipantsRequest.Unmarshal(m, b)
+func (m *ListShortlistRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListShortlistRequest.Unmarshal(m, b)
 }
-func (m *ListParticipantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListParticipantsRequest.Marshal(b, m, deterministic)
+func (m *ListShortlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListShortlistRequest.Marshal(b, m, deterministic)
 }
-func (m *ListParticipantsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListParticipantsRequest.Merge(m, src)
+func (m *ListShortlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListShortlistRequest.Merge(m, src)
 }
-func (m *ListParticipantsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListParticipantsRequest.Size(m)
+func (m *ListShortlistRequest) XXX_Size() int {
+	return xxx_messageInfo_ListShortlistRequest.Size(m)
 }
-func (m *ListParticipantsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListParticipantsRequest.DiscardUnknown(m)
+func (m *ListShortlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListShortlistRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListParticipantsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListShortlistRequest proto.InternalMessageInfo
 
-func (m *ListParticipantsRequest) GetLimit() int32 {
+func (m *ListShortlistRequest) GetLimit() int32 {
 	if m != nil {
 		return m.Limit
 	}
 	return 0
 }
 
-type ListParticipantsResponse struct {
-	Participants         []*Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *ListShortlistRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
 }
 
-func (m *ListParticipantsResponse) Reset()         { *m = ListParticipantsResponse{} }
-func (m *ListParticipantsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListParticipantsResponse) ProtoMessage()    {}
-func (*ListParticipantsResponse) Descriptor() ([]byte, []int) {
+type ListShortlistResponse struct {
+	Participants []*Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	// next_page_token is empty when there are no more participants
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListShortlistResponse) Reset()         { *m = ListShortlistResponse{} }
+func (m *ListShortlistResponse) String() string { return proto.CompactTextString(m) }
+func (*ListShortlistResponse) ProtoMessage()    {}
+func (*ListShortlistResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{9}
 }
 
-func (m *ListParticipantsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListParticipantsResponse.Unmarshal(m, b)
+func (m *ListShortlistResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListShortlistResponse.Unmarshal(m, b)
 }
-func (m *ListParticipantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListParticipantsResponse.Marshal(b, m, deterministic)
+func (m *ListShortlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListShortlistResponse.Marshal(b, m, deterministic)
 }
-func (m *ListParticipantsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListParticipantsResponse.Merge(m, src)
+func (m *ListShortlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListShortlistResponse.Merge(m, src)
 }
-func (m *ListParticipantsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListParticipantsResponse.Size(m)
+func (m *ListShortlistResponse) XXX_Size() int {
+	return xxx_messageInfo_ListShortlistResponse.Size(m)
 }
-func (m *ListParticipantsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListParticipantsResponse.DiscardUnknown(m)
+func (m *ListShortlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListShortlistResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListParticipantsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListShortlistResponse proto.InternalMessageInfo
 
-func (m *ListParticipantsResponse) GetParticipants() []*Participant {
+func (m *ListShortlistResponse) GetParticipants() []*Participant {
 	if m != nil {
 		return m.Participants
 	}
 	return nil
 }
 
-type UpdateSponsorRequest struct {
-	SponsorId            string   `protobuf:"bytes,1,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`
-	Sponsor              *Sponsor `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListShortlistResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
 }
 
-func (m *UpdateSponsorRequest) Reset()         { *m = UpdateSponsorRequest{} }
-func (m *UpdateSponsorRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateSponsorRequest) ProtoMessage()    {}
-func (*UpdateSponsorRequest) Descriptor() ([]byte, []int) {
+type CreateNoteRequest struct {
+	ParticipantId string `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Body          string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// rating is between 1 and 5, it is left out when the participant was
+	// not rated
+	Rating               *wrappers.Int32Value `protobuf:"bytes,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CreateNoteRequest) Reset()         { *m = CreateNoteRequest{} }
+func (m *CreateNoteRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateNoteRequest) ProtoMessage()    {}
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{10}
 }
 
-func (m *UpdateSponsorRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateSponsorRequest.Unmarshal(m, b)
+func (m *CreateNoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateNoteRequest.Unmarshal(m, b)
 }
-func (m *UpdateSponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateSponsorRequest.Marshal(b, m, deterministic)
+func (m *CreateNoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateNoteRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateSponsorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateSponsorRequest.Merge(m, src)
+func (m *CreateNoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateNoteRequest.Merge(m, src)
 }
-func (m *UpdateSponsorRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateSponsorRequest.Size(m)
+func (m *CreateNoteRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateNoteRequest.Size(m)
 }
-func (m *UpdateSponsorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateSponsorRequest.DiscardUnknown(m)
+func (m *CreateNoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateNoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateSponsorRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateNoteRequest proto.InternalMessageInfo
 
-func (m *UpdateSponsorRequest) GetSponsorId() string {
+func (m *CreateNoteRequest) GetParticipantId() string {
 	if m != nil {
-		return m.SponsorId
+		return m.ParticipantId
 	}
 	return ""
 }
 
-func (m *UpdateSponsorRequest) GetSponsor() *Sponsor {
+func (m *CreateNoteRequest) GetBody() string {
 	if m != nil {
-		return m.Sponsor
+		return m.Body
+	}
+	return ""
+}
+
+func (m *CreateNoteRequest) GetRating() *wrappers.Int32Value {
+	if m != nil {
+		return m.Rating
 	}
 	return nil
 }
 
-type UpdateSponsorResponse struct {
-	Sponsor              *Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+type CreateNoteResponse struct {
+	Note                 *Note    `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateSponsorResponse) Reset()         { *m = UpdateSponsorResponse{} }
-func (m *UpdateSponsorResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateSponsorResponse) ProtoMessage()    {}
-func (*UpdateSponsorResponse) Descriptor() ([]byte, []int) {
+func (m *CreateNoteResponse) Reset()         { *m = CreateNoteResponse{} }
+func (m *CreateNoteResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateNoteResponse) ProtoMessage()    {}
+func (*CreateNoteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{11}
 }
 
-func (m *UpdateSponsorResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateSponsorResponse.Unmarshal(m, b)
+func (m *CreateNoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateNoteResponse.Unmarshal(m, b)
 }
-func (m *UpdateSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateSponsorResponse.Marshal(b, m, deterministic)
+func (m *CreateNoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateNoteResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdateSponsorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateSponsorResponse.Merge(m, src)
+func (m *CreateNoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateNoteResponse.Merge(m, src)
 }
-func (m *UpdateSponsorResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateSponsorResponse.Size(m)
+func (m *CreateNoteResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateNoteResponse.Size(m)
 }
-func (m *UpdateSponsorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateSponsorResponse.DiscardUnknown(m)
+func (m *CreateNoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateNoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateSponsorResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateNoteResponse proto.InternalMessageInfo
 
-func (m *UpdateSponsorResponse) GetSponsor() *Sponsor {
+func (m *CreateNoteResponse) GetNote() *Note {
 	if m != nil {
-		return m.Sponsor
+		return m.Note
 	}
 	return nil
 }
 
-type UpdateAdminRequest struct {
-	AdminId              string   `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	Admin                *Admin   `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+type ListNotesRequest struct {
+	ParticipantId string `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Limit         int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// page_token is the next_page_token of a previous response
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateAdminRequest) Reset()         { *m = UpdateAdminRequest{} }
-func (m *UpdateAdminRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateAdminRequest) ProtoMessage()    {}
-func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
+func (m *ListNotesRequest) Reset()         { *m = ListNotesRequest{} }
+func (m *ListNotesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNotesRequest) ProtoMessage()    {}
+func (*ListNotesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{12}
 }
 
-func (m *UpdateAdminRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateAdminRequest.Unmarshal(m, b)
+func (m *ListNotesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNotesRequest.Unmarshal(m, b)
 }
-func (m *UpdateAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateAdminRequest.Marshal(b, m, deterministic)
+func (m *ListNotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNotesRequest.Marshal(b, m, deterministic)
 }
-func (m *UpdateAdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateAdminRequest.Merge(m, src)
+func (m *ListNotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNotesRequest.Merge(m, src)
 }
-func (m *UpdateAdminRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateAdminRequest.Size(m)
+func (m *ListNotesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNotesRequest.Size(m)
 }
-func (m *UpdateAdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateAdminRequest.DiscardUnknown(m)
+func (m *ListNotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNotesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateAdminRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListNotesRequest proto.InternalMessageInfo
 
-func (m *UpdateAdminRequest) GetAdminId() string {
+func (m *ListNotesRequest) GetParticipantId() string {
 	if m != nil {
-		return m.AdminId
+		return m.ParticipantId
 	}
 	return ""
 }
 
-func (m *UpdateAdminRequest) GetAdmin() *Admin {
+func (m *ListNotesRequest) GetLimit() int32 {
 	if m != nil {
-		return m.Admin
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-type UpdateAdminResponse struct {
-	Admin                *Admin   `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+func (m *ListNotesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListNotesResponse struct {
+	Notes []*Note `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	// next_page_token is empty when there are no more notes
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateAdminResponse) Reset()         { *m = UpdateAdminResponse{} }
-func (m *UpdateAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateAdminResponse) ProtoMessage()    {}
-func (*UpdateAdminResponse) Descriptor() ([]byte, []int) {
+func (m *ListNotesResponse) Reset()         { *m = ListNotesResponse{} }
+func (m *ListNotesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNotesResponse) ProtoMessage()    {}
+func (*ListNotesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{13}
 }
 
-func (m *UpdateAdminResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateAdminResponse.Unmarshal(m, b)
+func (m *ListNotesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNotesResponse.Unmarshal(m, b)
 }
-func (m *UpdateAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateAdminResponse.Marshal(b, m, deterministic)
+func (m *ListNotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNotesResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdateAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateAdminResponse.Merge(m, src)
+func (m *ListNotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNotesResponse.Merge(m, src)
 }
-func (m *UpdateAdminResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateAdminResponse.Size(m)
+func (m *ListNotesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListNotesResponse.Size(m)
 }
-func (m *UpdateAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateAdminResponse.DiscardUnknown(m)
+func (m *ListNotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNotesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateAdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListNotesResponse proto.InternalMessageInfo
 
-func (m *UpdateAdminResponse) GetAdmin() *Admin {
+func (m *ListNotesResponse) GetNotes() []*Note {
 	if m != nil {
-		return m.Admin
+		return m.Notes
 	}
 	return nil
 }
 
-type CreateSponsorRequest struct {
-	Sponsor              *Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListNotesResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
 }
 
-func (m *CreateSponsorRequest) Reset()         { *m = CreateSponsorRequest{} }
-func (m *CreateSponsorRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateSponsorRequest) ProtoMessage()    {}
-func (*CreateSponsorRequest) Descriptor() ([]byte, []int) {
+type UpdateNoteRequest struct {
+	NoteId string `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Body   string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// rating is between 1 and 5, leaving it out removes the rating
+	Rating               *wrappers.Int32Value `protobuf:"bytes,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UpdateNoteRequest) Reset()         { *m = UpdateNoteRequest{} }
+func (m *UpdateNoteRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateNoteRequest) ProtoMessage()    {}
+func (*UpdateNoteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{14}
 }
 
-func (m *CreateSponsorRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateSponsorRequest.Unmarshal(m, b)
+func (m *UpdateNoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNoteRequest.Unmarshal(m, b)
 }
-func (m *CreateSponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateSponsorRequest.Marshal(b, m, deterministic)
+func (m *UpdateNoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNoteRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateSponsorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateSponsorRequest.Merge(m, src)
+func (m *UpdateNoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNoteRequest.Merge(m, src)
 }
-func (m *CreateSponsorRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateSponsorRequest.Size(m)
+func (m *UpdateNoteRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateNoteRequest.Size(m)
 }
-func (m *CreateSponsorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateSponsorRequest.DiscardUnknown(m)
+func (m *UpdateNoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateSponsorRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateNoteRequest proto.InternalMessageInfo
 
-func (m *CreateSponsorRequest) GetSponsor() *Sponsor {
+func (m *UpdateNoteRequest) GetNoteId() string {
 	if m != nil {
-		return m.Sponsor
+		return m.NoteId
+	}
+	return ""
+}
+
+func (m *UpdateNoteRequest) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *UpdateNoteRequest) GetRating() *wrappers.Int32Value {
+	if m != nil {
+		return m.Rating
 	}
 	return nil
 }
 
-type CreateSponsorResponse struct {
-	Sponsor              *Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+type UpdateNoteResponse struct {
+	Note                 *Note    `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateSponsorResponse) Reset()         { *m = CreateSponsorResponse{} }
-func (m *CreateSponsorResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateSponsorResponse) ProtoMessage()    {}
-func (*CreateSponsorResponse) Descriptor() ([]byte, []int) {
+func (m *UpdateNoteResponse) Reset()         { *m = UpdateNoteResponse{} }
+func (m *UpdateNoteResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateNoteResponse) ProtoMessage()    {}
+func (*UpdateNoteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{15}
 }
 
-func (m *CreateSponsorResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateSponsorResponse.Unmarshal(m, b)
+func (m *UpdateNoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNoteResponse.Unmarshal(m, b)
 }
-func (m *CreateSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateSponsorResponse.Marshal(b, m, deterministic)
+func (m *UpdateNoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNoteResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateSponsorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateSponsorResponse.Merge(m, src)
+func (m *UpdateNoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNoteResponse.Merge(m, src)
 }
-func (m *CreateSponsorResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateSponsorResponse.Size(m)
+func (m *UpdateNoteResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateNoteResponse.Size(m)
 }
-func (m *CreateSponsorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateSponsorResponse.DiscardUnknown(m)
+func (m *UpdateNoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateSponsorResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateNoteResponse proto.InternalMessageInfo
 
-func (m *CreateSponsorResponse) GetSponsor() *Sponsor {
+func (m *UpdateNoteResponse) GetNote() *Note {
 	if m != nil {
-		return m.Sponsor
+		return m.Note
 	}
 	return nil
 }
 
-type CreateCompanyRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Logo                 string   `protobuf:"bytes,2,opt,name=logo,proto3" json:"logo,omitempty"`
+type DeleteNoteRequest struct {
+	NoteId               string   `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateCompanyRequest) Reset()         { *m = CreateCompanyRequest{} }
-func (m *CreateCompanyRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateCompanyRequest) ProtoMessage()    {}
-func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+func (m *DeleteNoteRequest) Reset()         { *m = DeleteNoteRequest{} }
+func (m *DeleteNoteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteNoteRequest) ProtoMessage()    {}
+func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{16}
 }
 
-func (m *CreateCompanyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCompanyRequest.Unmarshal(m, b)
+func (m *DeleteNoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNoteRequest.Unmarshal(m, b)
 }
-func (m *CreateCompanyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCompanyRequest.Marshal(b, m, deterministic)
+func (m *DeleteNoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNoteRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateCompanyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCompanyRequest.Merge(m, src)
+func (m *DeleteNoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNoteRequest.Merge(m, src)
 }
-func (m *CreateCompanyRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateCompanyRequest.Size(m)
+func (m *DeleteNoteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteNoteRequest.Size(m)
 }
-func (m *CreateCompanyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCompanyRequest.DiscardUnknown(m)
+func (m *DeleteNoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCompanyRequest proto.InternalMessageInfo
-
-func (m *CreateCompanyRequest) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_DeleteNoteRequest proto.InternalMessageInfo
 
-func (m *CreateCompanyRequest) GetLogo() string {
+func (m *DeleteNoteRequest) GetNoteId() string {
 	if m != nil {
-		return m.Logo
+		return m.NoteId
 	}
 	return ""
 }
 
-type CreateCompanyResponse struct {
-	Company              *Company `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+type DeleteNoteResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateCompanyResponse) Reset()         { *m = CreateCompanyResponse{} }
-func (m *CreateCompanyResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateCompanyResponse) ProtoMessage()    {}
-func (*CreateCompanyResponse) Descriptor() ([]byte, []int) {
+func (m *DeleteNoteResponse) Reset()         { *m = DeleteNoteResponse{} }
+func (m *DeleteNoteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteNoteResponse) ProtoMessage()    {}
+func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{17}
 }
 
-func (m *CreateCompanyResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCompanyResponse.Unmarshal(m, b)
+func (m *DeleteNoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNoteResponse.Unmarshal(m, b)
 }
-func (m *CreateCompanyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCompanyResponse.Marshal(b, m, deterministic)
+func (m *DeleteNoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNoteResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateCompanyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCompanyResponse.Merge(m, src)
+func (m *DeleteNoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNoteResponse.Merge(m, src)
 }
-func (m *CreateCompanyResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateCompanyResponse.Size(m)
+func (m *DeleteNoteResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteNoteResponse.Size(m)
 }
-func (m *CreateCompanyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCompanyResponse.DiscardUnknown(m)
+func (m *DeleteNoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCompanyResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteNoteResponse proto.InternalMessageInfo
 
-func (m *CreateCompanyResponse) GetCompany() *Company {
+func (m *DeleteNoteResponse) GetOk() bool {
 	if m != nil {
-		return m.Company
+		return m.Ok
 	}
-	return nil
+	return false
 }
 
-type LoginAdminRequest struct {
-	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+type GetSponsorRequest struct {
+	SponsorId            string   `protobuf:"bytes,1,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoginAdminRequest) Reset()         { *m = LoginAdminRequest{} }
-func (m *LoginAdminRequest) String() string { return proto.CompactTextString(m) }
-func (*LoginAdminRequest) ProtoMessage()    {}
-func (*LoginAdminRequest) Descriptor() ([]byte, []int) {
+func (m *GetSponsorRequest) Reset()         { *m = GetSponsorRequest{} }
+func (m *GetSponsorRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSponsorRequest) ProtoMessage()    {}
+func (*GetSponsorRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{18}
 }
 
-func (m *LoginAdminRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoginAdminRequest.Unmarshal(m, b)
+func (m *GetSponsorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSponsorRequest.Unmarshal(m, b)
 }
-func (m *LoginAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoginAdminRequest.Marshal(b, m, deterministic)
+func (m *GetSponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSponsorRequest.Marshal(b, m, deterministic)
 }
-func (m *LoginAdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoginAdminRequest.Merge(m, src)
+func (m *GetSponsorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSponsorRequest.Merge(m, src)
 }
-func (m *LoginAdminRequest) XXX_Size() int {
-	return xxx_messageInfo_LoginAdminRequest.Size(m)
+func (m *GetSponsorRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSponsorRequest.Size(m)
 }
-func (m *LoginAdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoginAdminRequest.DiscardUnknown(m)
+func (m *GetSponsorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSponsorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoginAdminRequest proto.InternalMessageInfo
-
-func (m *LoginAdminRequest) GetEmail() string {
-	if m != nil {
-		return m.Email
-	}
-	return ""
-}
+var xxx_messageInfo_GetSponsorRequest proto.InternalMessageInfo
 
-func (m *LoginAdminRequest) GetPassword() string {
+func (m *GetSponsorRequest) GetSponsorId() string {
 	if m != nil {
-		return m.Password
+		return m.SponsorId
 	}
 	return ""
 }
 
-type LoginAdminResponse struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Admin                *Admin   `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+type GetSponsorResponse struct {
+	Sponsor              *Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoginAdminResponse) Reset()         { *m = LoginAdminResponse{} }
-func (m *LoginAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*LoginAdminResponse) ProtoMessage()    {}
-func (*LoginAdminResponse) Descriptor() ([]byte, []int) {
+func (m *GetSponsorResponse) Reset()         { *m = GetSponsorResponse{} }
+func (m *GetSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSponsorResponse) ProtoMessage()    {}
+func (*GetSponsorResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{19}
 }
 
-func (m *LoginAdminResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoginAdminResponse.Unmarshal(m, b)
+func (m *GetSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSponsorResponse.Unmarshal(m, b)
 }
-func (m *LoginAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoginAdminResponse.Marshal(b, m, deterministic)
+func (m *GetSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSponsorResponse.Marshal(b, m, deterministic)
 }
-func (m *LoginAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoginAdminResponse.Merge(m, src)
+func (m *GetSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSponsorResponse.Merge(m, src)
 }
-func (m *LoginAdminResponse) XXX_Size() int {
-	return xxx_messageInfo_LoginAdminResponse.Size(m)
+func (m *GetSponsorResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSponsorResponse.Size(m)
 }
-func (m *LoginAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoginAdminResponse.DiscardUnknown(m)
+func (m *GetSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSponsorResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoginAdminResponse proto.InternalMessageInfo
-
-func (m *LoginAdminResponse) GetToken() string {
-	if m != nil {
-		return m.Token
-	}
-	return ""
-}
+var xxx_messageInfo_GetSponsorResponse proto.InternalMessageInfo
 
-func (m *LoginAdminResponse) GetAdmin() *Admin {
+func (m *GetSponsorResponse) GetSponsor() *Sponsor {
 	if m != nil {
-		return m.Admin
+		return m.Sponsor
 	}
 	return nil
 }
 
-type DeleteAdminRequest struct {
-	AdminId              string   `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+type ResumesRequest struct {
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// format of the archive, either tar (default) or zip
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// participant_ids narrows down the archive to the resumes of the given
+	// participants, the filters below are applied on top of it
+	ParticipantIds []string `protobuf:"bytes,3,rep,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"`
+	// the filters are the same as the filters of ListParticipantsRequest
+	University  string `protobuf:"bytes,4,opt,name=university,proto3" json:"university,omitempty"`
+	Major       string `protobuf:"bytes,5,opt,name=major,proto3" json:"major,omitempty"`
+	GradYearMin int32  `protobuf:"varint,6,opt,name=grad_year_min,json=gradYearMin,proto3" json:"grad_year_min,omitempty"`
+	GradYearMax int32  `protobuf:"varint,7,opt,name=grad_year_max,json=gradYearMax,proto3" json:"grad_year_max,omitempty"`
+	Query       string `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"`
+	// shortlisted only includes the participants on the shortlist of the
+	// company of the sponsor making the request
+	Shortlisted          bool     `protobuf:"varint,9,opt,name=shortlisted,proto3" json:"shortlisted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAdminRequest) Reset()         { *m = DeleteAdminRequest{} }
-func (m *DeleteAdminRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteAdminRequest) ProtoMessage()    {}
-func (*DeleteAdminRequest) Descriptor() ([]byte, []int) {
+func (m *ResumesRequest) Reset()         { *m = ResumesRequest{} }
+func (m *ResumesRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumesRequest) ProtoMessage()    {}
+func (*ResumesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{20}
 }
 
-func (m *DeleteAdminRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAdminRequest.Unmarshal(m, b)
+func (m *ResumesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumesRequest.Unmarshal(m, b)
 }
-func (m *DeleteAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAdminRequest.Marshal(b, m, deterministic)
+func (m *ResumesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumesRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteAdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAdminRequest.Merge(m, src)
+func (m *ResumesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumesRequest.Merge(m, src)
 }
-func (m *DeleteAdminRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteAdminRequest.Size(m)
+func (m *ResumesRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumesRequest.Size(m)
 }
-func (m *DeleteAdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAdminRequest.DiscardUnknown(m)
+func (m *ResumesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAdminRequest proto.InternalMessageInfo
+var xxx_messageInfo_ResumesRequest proto.InternalMessageInfo
 
-func (m *DeleteAdminRequest) GetAdminId() string {
+func (m *ResumesRequest) GetLimit() int32 {
 	if m != nil {
-		return m.AdminId
+		return m.Limit
 	}
-	return ""
+	return 0
 }
 
-type DeleteAdminResponse struct {
-	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ResumesRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
 }
 
-func (m *DeleteAdminResponse) Reset()         { *m = DeleteAdminResponse{} }
-func (m *DeleteAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteAdminResponse) ProtoMessage()    {}
-func (*DeleteAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b93c2e50814def43, []int{21}
+func (m *ResumesRequest) GetParticipantIds() []string {
+	if m != nil {
+		return m.ParticipantIds
+	}
+	return nil
 }
 
-func (m *DeleteAdminResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAdminResponse.Unmarshal(m, b)
-}
-func (m *DeleteAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAdminResponse.Marshal(b, m, deterministic)
+func (m *ResumesRequest) GetUniversity() string {
+	if m != nil {
+		return m.University
+	}
+	return ""
 }
-func (m *DeleteAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAdminResponse.Merge(m, src)
+
+func (m *ResumesRequest) GetMajor() string {
+	if m != nil {
+		return m.Major
+	}
+	return ""
 }
-func (m *DeleteAdminResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteAdminResponse.Size(m)
+
+func (m *ResumesRequest) GetGradYearMin() int32 {
+	if m != nil {
+		return m.GradYearMin
+	}
+	return 0
 }
-func (m *DeleteAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAdminResponse.DiscardUnknown(m)
+
+func (m *ResumesRequest) GetGradYearMax() int32 {
+	if m != nil {
+		return m.GradYearMax
+	}
+	return 0
 }
 
-var xxx_messageInfo_DeleteAdminResponse proto.InternalMessageInfo
+func (m *ResumesRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
 
-func (m *DeleteAdminResponse) GetOk() bool {
+func (m *ResumesRequest) GetShortlisted() bool {
 	if m != nil {
-		return m.Ok
+		return m.Shortlisted
 	}
 	return false
 }
 
-type CreateAdminRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	PasswordPlainText    string   `protobuf:"bytes,3,opt,name=password_plain_text,json=passwordPlainText,proto3" json:"password_plain_text,omitempty"`
+type ResumesResponse struct {
+	Archive              []byte   `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateAdminRequest) Reset()         { *m = CreateAdminRequest{} }
-func (m *CreateAdminRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateAdminRequest) ProtoMessage()    {}
-func (*CreateAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b93c2e50814def43, []int{22}
+func (m *ResumesResponse) Reset()         { *m = ResumesResponse{} }
+func (m *ResumesResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumesResponse) ProtoMessage()    {}
+func (*ResumesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{21}
 }
 
-func (m *CreateAdminRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateAdminRequest.Unmarshal(m, b)
-}
-func (m *CreateAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateAdminRequest.Marshal(b, m, deterministic)
+func (m *ResumesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumesResponse.Unmarshal(m, b)
 }
-func (m *CreateAdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateAdminRequest.Merge(m, src)
+func (m *ResumesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumesResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateAdminRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateAdminRequest.Size(m)
+func (m *ResumesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumesResponse.Merge(m, src)
 }
-func (m *CreateAdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateAdminRequest.DiscardUnknown(m)
+func (m *ResumesResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumesResponse.Size(m)
 }
-
-var xxx_messageInfo_CreateAdminRequest proto.InternalMessageInfo
-
-func (m *CreateAdminRequest) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (m *ResumesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumesResponse.DiscardUnknown(m)
 }
 
-func (m *CreateAdminRequest) GetEmail() string {
-	if m != nil {
-		return m.Email
-	}
-	return ""
-}
+var xxx_messageInfo_ResumesResponse proto.InternalMessageInfo
 
-func (m *CreateAdminRequest) GetPasswordPlainText() string {
+func (m *ResumesResponse) GetArchive() []byte {
 	if m != nil {
-		return m.PasswordPlainText
+		return m.Archive
 	}
-	return ""
+	return nil
 }
 
-type CreateAdminResponse struct {
-	Admin                *Admin   `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+type ResumesChunk struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateAdminResponse) Reset()         { *m = CreateAdminResponse{} }
-func (m *CreateAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateAdminResponse) ProtoMessage()    {}
-func (*CreateAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b93c2e50814def43, []int{23}
+func (m *ResumesChunk) Reset()         { *m = ResumesChunk{} }
+func (m *ResumesChunk) String() string { return proto.CompactTextString(m) }
+func (*ResumesChunk) ProtoMessage()    {}
+func (*ResumesChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{22}
 }
 
-func (m *CreateAdminResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateAdminResponse.Unmarshal(m, b)
+func (m *ResumesChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumesChunk.Unmarshal(m, b)
 }
-func (m *CreateAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateAdminResponse.Marshal(b, m, deterministic)
+func (m *ResumesChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumesChunk.Marshal(b, m, deterministic)
 }
-func (m *CreateAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateAdminResponse.Merge(m, src)
+func (m *ResumesChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumesChunk.Merge(m, src)
 }
-func (m *CreateAdminResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateAdminResponse.Size(m)
+func (m *ResumesChunk) XXX_Size() int {
+	return xxx_messageInfo_ResumesChunk.Size(m)
 }
-func (m *CreateAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateAdminResponse.DiscardUnknown(m)
+func (m *ResumesChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumesChunk.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateAdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_ResumesChunk proto.InternalMessageInfo
 
-func (m *CreateAdminResponse) GetAdmin() *Admin {
+func (m *ResumesChunk) GetData() []byte {
 	if m != nil {
-		return m.Admin
+		return m.Data
 	}
 	return nil
 }
 
-type GetAdminRequest struct {
-	AdminId              string   `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+type GetResumeRequest struct {
+	ParticipantId string `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	// inline returns the resume in data instead of a signed url
+	Inline               bool     `protobuf:"varint,2,opt,name=inline,proto3" json:"inline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAdminRequest) Reset()         { *m = GetAdminRequest{} }
-func (m *GetAdminRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAdminRequest) ProtoMessage()    {}
-func (*GetAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b93c2e50814def43, []int{24}
+func (m *GetResumeRequest) Reset()         { *m = GetResumeRequest{} }
+func (m *GetResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetResumeRequest) ProtoMessage()    {}
+func (*GetResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{23}
 }
 
-func (m *GetAdminRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAdminRequest.Unmarshal(m, b)
+func (m *GetResumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResumeRequest.Unmarshal(m, b)
 }
-func (m *GetAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAdminRequest.Marshal(b, m, deterministic)
+func (m *GetResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResumeRequest.Marshal(b, m, deterministic)
 }
-func (m *GetAdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAdminRequest.Merge(m, src)
+func (m *GetResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResumeRequest.Merge(m, src)
 }
-func (m *GetAdminRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAdminRequest.Size(m)
+func (m *GetResumeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetResumeRequest.Size(m)
 }
-func (m *GetAdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAdminRequest.DiscardUnknown(m)
+func (m *GetResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResumeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAdminRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetResumeRequest proto.InternalMessageInfo
 
-func (m *GetAdminRequest) GetAdminId() string {
+func (m *GetResumeRequest) GetParticipantId() string {
 	if m != nil {
-		return m.AdminId
+		return m.ParticipantId
 	}
 	return ""
 }
 
-type GetAdminResponse struct {
-	Admin                *Admin   `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+func (m *GetResumeRequest) GetInline() bool {
+	if m != nil {
+		return m.Inline
+	}
+	return false
+}
+
+type GetResumeResponse struct {
+	Data        []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// url is the path of the signed url on the gateway, it expires at
+	// expires_at (unix seconds)
+	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAdminResponse) Reset()         { *m = GetAdminResponse{} }
-func (m *GetAdminResponse) String() string { return proto.CompactTextString(m) }
-func (*GetAdminResponse) ProtoMessage()    {}
-func (*GetAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b93c2e50814def43, []int{25}
+func (m *GetResumeResponse) Reset()         { *m = GetResumeResponse{} }
+func (m *GetResumeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetResumeResponse) ProtoMessage()    {}
+func (*GetResumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{24}
 }
 
-func (m *GetAdminResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAdminResponse.Unmarshal(m, b)
+func (m *GetResumeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResumeResponse.Unmarshal(m, b)
 }
-func (m *GetAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAdminResponse.Marshal(b, m, deterministic)
+func (m *GetResumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResumeResponse.Marshal(b, m, deterministic)
 }
-func (m *GetAdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAdminResponse.Merge(m, src)
+func (m *GetResumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResumeResponse.Merge(m, src)
 }
-func (m *GetAdminResponse) XXX_Size() int {
-	return xxx_messageInfo_GetAdminResponse.Size(m)
+func (m *GetResumeResponse) XXX_Size() int {
+	return xxx_messageInfo_GetResumeResponse.Size(m)
 }
-func (m *GetAdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAdminResponse.DiscardUnknown(m)
+func (m *GetResumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResumeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetResumeResponse proto.InternalMessageInfo
 
-func (m *GetAdminResponse) GetAdmin() *Admin {
+func (m *GetResumeResponse) GetData() []byte {
 	if m != nil {
-		return m.Admin
+		return m.Data
 	}
 	return nil
 }
 
-type Admin struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email                string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Password             string   `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	ACL                  string   `protobuf:"bytes,5,opt,name=ACL,proto3" json:"ACL,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetResumeResponse) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
 }
 
-func (m *Admin) Reset()         { *m = Admin{} }
-func (m *Admin) String() string { return proto.CompactTextString(m) }
-func (*Admin) ProtoMessage()    {}
-func (*Admin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b93c2e50814def43, []int{26}
+func (m *GetResumeResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
 }
 
-func (m *Admin) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Admin.Unmarshal(m, b)
+func (m *GetResumeResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
-func (m *Admin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Admin.Marshal(b, m, deterministic)
+
+type ListCompaniesResponse struct {
+	Companies []*Company `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	// next_page_token is empty when there are no more companies
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *Admin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Admin.Merge(m, src)
+
+func (m *ListCompaniesResponse) Reset()         { *m = ListCompaniesResponse{} }
+func (m *ListCompaniesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCompaniesResponse) ProtoMessage()    {}
+func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{25}
 }
-func (m *Admin) XXX_Size() int {
-	return xxx_messageInfo_Admin.Size(m)
+
+func (m *ListCompaniesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCompaniesResponse.Unmarshal(m, b)
 }
-func (m *Admin) XXX_DiscardUnknown() {
-	xxx_messageInfo_Admin.DiscardUnknown(m)
+func (m *ListCompaniesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCompaniesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCompaniesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCompaniesResponse.Merge(m, src)
+}
+func (m *ListCompaniesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCompaniesResponse.Size(m)
+}
+func (m *ListCompaniesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCompaniesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Admin proto.InternalMessageInfo
+var xxx_messageInfo_ListCompaniesResponse proto.InternalMessageInfo
 
-func (m *Admin) GetId() string {
+func (m *ListCompaniesResponse) GetCompanies() []*Company {
 	if m != nil {
-		return m.Id
+		return m.Companies
 	}
-	return ""
+	return nil
 }
 
-func (m *Admin) GetName() string {
+func (m *ListCompaniesResponse) GetNextPageToken() string {
 	if m != nil {
-		return m.Name
+		return m.NextPageToken
 	}
 	return ""
 }
 
-func (m *Admin) GetEmail() string {
-	if m != nil {
-		return m.Email
-	}
-	return ""
+type ListCompaniesRequest struct {
+	// limit is an optional field
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// page_token is the next_page_token of a previous response
+	PageToken            string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Admin) GetPassword() string {
+func (m *ListCompaniesRequest) Reset()         { *m = ListCompaniesRequest{} }
+func (m *ListCompaniesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCompaniesRequest) ProtoMessage()    {}
+func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{26}
+}
+
+func (m *ListCompaniesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCompaniesRequest.Unmarshal(m, b)
+}
+func (m *ListCompaniesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCompaniesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCompaniesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCompaniesRequest.Merge(m, src)
+}
+func (m *ListCompaniesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCompaniesRequest.Size(m)
+}
+func (m *ListCompaniesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCompaniesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCompaniesRequest proto.InternalMessageInfo
+
+func (m *ListCompaniesRequest) GetLimit() int32 {
 	if m != nil {
-		return m.Password
+		return m.Limit
 	}
-	return ""
+	return 0
 }
 
-func (m *Admin) GetACL() string {
+func (m *ListCompaniesRequest) GetPageToken() string {
 	if m != nil {
-		return m.ACL
+		return m.PageToken
 	}
 	return ""
 }
 
-type Sponsor struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email                string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Password             string   `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	Company              *Company `protobuf:"bytes,5,opt,name=company,proto3" json:"company,omitempty"`
-	ACL                  string   `protobuf:"bytes,6,opt,name=ACL,proto3" json:"ACL,omitempty"`
+type LoginSponsorRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	PasswordPlainText    string   `protobuf:"bytes,2,opt,name=password_plain_text,json=passwordPlainText,proto3" json:"password_plain_text,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Sponsor) Reset()         { *m = Sponsor{} }
-func (m *Sponsor) String() string { return proto.CompactTextString(m) }
-func (*Sponsor) ProtoMessage()    {}
-func (*Sponsor) Descriptor() ([]byte, []int) {
+func (m *LoginSponsorRequest) Reset()         { *m = LoginSponsorRequest{} }
+func (m *LoginSponsorRequest) String() string { return proto.CompactTextString(m) }
+func (*LoginSponsorRequest) ProtoMessage()    {}
+func (*LoginSponsorRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b93c2e50814def43, []int{27}
 }
 
-func (m *Sponsor) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Sponsor.Unmarshal(m, b)
+func (m *LoginSponsorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginSponsorRequest.Unmarshal(m, b)
 }
-func (m *Sponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Sponsor.Marshal(b, m, deterministic)
+func (m *LoginSponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginSponsorRequest.Marshal(b, m, deterministic)
 }
-func (m *Sponsor) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Sponsor.Merge(m, src)
+func (m *LoginSponsorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginSponsorRequest.Merge(m, src)
 }
-func (m *Sponsor) XXX_Size() int {
-	return xxx_messageInfo_Sponsor.Size(m)
+func (m *LoginSponsorRequest) XXX_Size() int {
+	return xxx_messageInfo_LoginSponsorRequest.Size(m)
 }
-func (m *Sponsor) XXX_DiscardUnknown() {
-	xxx_messageInfo_Sponsor.DiscardUnknown(m)
+func (m *LoginSponsorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginSponsorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Sponsor proto.InternalMessageInfo
+var xxx_messageInfo_LoginSponsorRequest proto.InternalMessageInfo
 
-func (m *Sponsor) GetId() string {
+func (m *LoginSponsorRequest) GetEmail() string {
 	if m != nil {
-		return m.Id
+		return m.Email
 	}
 	return ""
 }
 
-func (m *Sponsor) GetName() string {
+func (m *LoginSponsorRequest) GetPasswordPlainText() string {
 	if m != nil {
-		return m.Name
+		return m.PasswordPlainText
 	}
 	return ""
 }
 
-func (m *Sponsor) GetEmail() string {
-	if m != nil {
-		return m.Email
-	}
-	return ""
+type LoginSponsorResponse struct {
+	Token        string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Sponsor      *Sponsor `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	RefreshToken string   `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// expires_at is the unix time at which the access token expires
+	ExpiresAt            int64    `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Sponsor) GetPassword() string {
+func (m *LoginSponsorResponse) Reset()         { *m = LoginSponsorResponse{} }
+func (m *LoginSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*LoginSponsorResponse) ProtoMessage()    {}
+func (*LoginSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{28}
+}
+
+func (m *LoginSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginSponsorResponse.Unmarshal(m, b)
+}
+func (m *LoginSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginSponsorResponse.Marshal(b, m, deterministic)
+}
+func (m *LoginSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginSponsorResponse.Merge(m, src)
+}
+func (m *LoginSponsorResponse) XXX_Size() int {
+	return xxx_messageInfo_LoginSponsorResponse.Size(m)
+}
+func (m *LoginSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginSponsorResponse proto.InternalMessageInfo
+
+func (m *LoginSponsorResponse) GetToken() string {
 	if m != nil {
-		return m.Password
+		return m.Token
 	}
 	return ""
 }
 
-func (m *Sponsor) GetCompany() *Company {
+func (m *LoginSponsorResponse) GetSponsor() *Sponsor {
 	if m != nil {
-		return m.Company
+		return m.Sponsor
 	}
 	return nil
 }
 
-func (m *Sponsor) GetACL() string {
+func (m *LoginSponsorResponse) GetRefreshToken() string {
 	if m != nil {
-		return m.ACL
+		return m.RefreshToken
 	}
 	return ""
 }
 
-type Company struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Logo                 string   `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
+func (m *LoginSponsorResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Company) Reset()         { *m = Company{} }
-func (m *Company) String() string { return proto.CompactTextString(m) }
-func (*Company) ProtoMessage()    {}
-func (*Company) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b93c2e50814def43, []int{28}
+func (m *RefreshTokenRequest) Reset()         { *m = RefreshTokenRequest{} }
+func (m *RefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenRequest) ProtoMessage()    {}
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{29}
 }
 
-func (m *Company) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Company.Unmarshal(m, b)
-}
-func (m *Company) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Company.Marshal(b, m, deterministic)
+func (m *RefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenRequest.Unmarshal(m, b)
 }
-func (m *Company) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Company.Merge(m, src)
+func (m *RefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenRequest.Marshal(b, m, deterministic)
 }
-func (m *Company) XXX_Size() int {
-	return xxx_messageInfo_Company.Size(m)
+func (m *RefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenRequest.Merge(m, src)
 }
-func (m *Company) XXX_DiscardUnknown() {
-	xxx_messageInfo_Company.DiscardUnknown(m)
+func (m *RefreshTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenRequest.Size(m)
 }
-
-var xxx_messageInfo_Company proto.InternalMessageInfo
-
-func (m *Company) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *RefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenRequest.DiscardUnknown(m)
 }
 
-func (m *Company) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_RefreshTokenRequest proto.InternalMessageInfo
 
-func (m *Company) GetLogo() string {
+func (m *RefreshTokenRequest) GetRefreshToken() string {
 	if m != nil {
-		return m.Logo
+		return m.RefreshToken
 	}
 	return ""
 }
 
-type Participant struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Github               string   `protobuf:"bytes,3,opt,name=github,proto3" json:"github,omitempty"`
-	Linkedin             string   `protobuf:"bytes,4,opt,name=linkedin,proto3" json:"linkedin,omitempty"`
-	Resume               string   `protobuf:"bytes,5,opt,name=resume,proto3" json:"resume,omitempty"`
-	Email                string   `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	University           string   `protobuf:"bytes,7,opt,name=university,proto3" json:"university,omitempty"`
-	Major                string   `protobuf:"bytes,8,opt,name=major,proto3" json:"major,omitempty"`
-	GradYear             int32    `protobuf:"varint,9,opt,name=grad_year,json=gradYear,proto3" json:"grad_year,omitempty"`
+type RefreshTokenResponse struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Participant) Reset()         { *m = Participant{} }
-func (m *Participant) String() string { return proto.CompactTextString(m) }
-func (*Participant) ProtoMessage()    {}
-func (*Participant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b93c2e50814def43, []int{29}
+func (m *RefreshTokenResponse) Reset()         { *m = RefreshTokenResponse{} }
+func (m *RefreshTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenResponse) ProtoMessage()    {}
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{30}
 }
 
-func (m *Participant) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Participant.Unmarshal(m, b)
+func (m *RefreshTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenResponse.Unmarshal(m, b)
 }
-func (m *Participant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Participant.Marshal(b, m, deterministic)
+func (m *RefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenResponse.Marshal(b, m, deterministic)
 }
-func (m *Participant) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Participant.Merge(m, src)
+func (m *RefreshTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenResponse.Merge(m, src)
 }
-func (m *Participant) XXX_Size() int {
-	return xxx_messageInfo_Participant.Size(m)
+func (m *RefreshTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenResponse.Size(m)
 }
-func (m *Participant) XXX_DiscardUnknown() {
-	xxx_messageInfo_Participant.DiscardUnknown(m)
+func (m *RefreshTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Participant proto.InternalMessageInfo
+var xxx_messageInfo_RefreshTokenResponse proto.InternalMessageInfo
 
-func (m *Participant) GetId() string {
+func (m *RefreshTokenResponse) GetToken() string {
 	if m != nil {
-		return m.Id
+		return m.Token
 	}
 	return ""
 }
 
-func (m *Participant) GetName() string {
+func (m *RefreshTokenResponse) GetRefreshToken() string {
 	if m != nil {
-		return m.Name
+		return m.RefreshToken
 	}
 	return ""
 }
 
-func (m *Participant) GetGithub() string {
+func (m *RefreshTokenResponse) GetExpiresAt() int64 {
 	if m != nil {
-		return m.Github
+		return m.ExpiresAt
 	}
-	return ""
+	return 0
 }
 
-func (m *Participant) GetLinkedin() string {
+type LogoutRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Everywhere           bool     `protobuf:"varint,2,opt,name=everywhere,proto3" json:"everywhere,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
+func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutRequest) ProtoMessage()    {}
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{31}
+}
+
+func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
+}
+func (m *LogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutRequest.Marshal(b, m, deterministic)
+}
+func (m *LogoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutRequest.Merge(m, src)
+}
+func (m *LogoutRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutRequest.Size(m)
+}
+func (m *LogoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutRequest proto.InternalMessageInfo
+
+func (m *LogoutRequest) GetRefreshToken() string {
 	if m != nil {
-		return m.Linkedin
+		return m.RefreshToken
 	}
 	return ""
 }
 
-func (m *Participant) GetResume() string {
+func (m *LogoutRequest) GetEverywhere() bool {
 	if m != nil {
-		return m.Resume
+		return m.Everywhere
 	}
-	return ""
+	return false
 }
 
-func (m *Participant) GetEmail() string {
+type LogoutResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutResponse) Reset()         { *m = LogoutResponse{} }
+func (m *LogoutResponse) String() string { return proto.CompactTextString(m) }
+func (*LogoutResponse) ProtoMessage()    {}
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{32}
+}
+
+func (m *LogoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutResponse.Unmarshal(m, b)
+}
+func (m *LogoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutResponse.Marshal(b, m, deterministic)
+}
+func (m *LogoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutResponse.Merge(m, src)
+}
+func (m *LogoutResponse) XXX_Size() int {
+	return xxx_messageInfo_LogoutResponse.Size(m)
+}
+func (m *LogoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutResponse proto.InternalMessageInfo
+
+func (m *LogoutResponse) GetOk() bool {
 	if m != nil {
-		return m.Email
+		return m.Ok
 	}
-	return ""
+	return false
 }
 
-func (m *Participant) GetUniversity() string {
+type ListParticipantsRequest struct {
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// university, major and query are matched case insensitively.
+	// query is matched against both the name and the email of a participant
+	University string `protobuf:"bytes,2,opt,name=university,proto3" json:"university,omitempty"`
+	Major      string `protobuf:"bytes,3,opt,name=major,proto3" json:"major,omitempty"`
+	// grad_year_min and grad_year_max define an inclusive range, a zero
+	// value means that end of the range is open
+	GradYearMin int32  `protobuf:"varint,4,opt,name=grad_year_min,json=gradYearMin,proto3" json:"grad_year_min,omitempty"`
+	GradYearMax int32  `protobuf:"varint,5,opt,name=grad_year_max,json=gradYearMax,proto3" json:"grad_year_max,omitempty"`
+	HasResume   bool   `protobuf:"varint,6,opt,name=has_resume,json=hasResume,proto3" json:"has_resume,omitempty"`
+	HasGithub   bool   `protobuf:"varint,7,opt,name=has_github,json=hasGithub,proto3" json:"has_github,omitempty"`
+	Query       string `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"`
+	// page_token is the next_page_token of a previous response
+	PageToken string `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// shortlisted only returns the participants on the shortlist of the
+	// company of the sponsor making the request
+	Shortlisted          bool     `protobuf:"varint,10,opt,name=shortlisted,proto3" json:"shortlisted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListParticipantsRequest) Reset()         { *m = ListParticipantsRequest{} }
+func (m *ListParticipantsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListParticipantsRequest) ProtoMessage()    {}
+func (*ListParticipantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{33}
+}
+
+func (m *ListParticipantsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListParticipantsRequest.Unmarshal(m, b)
+}
+func (m *ListParticipantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListParticipantsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListParticipantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListParticipantsRequest.Merge(m, src)
+}
+func (m *ListParticipantsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListParticipantsRequest.Size(m)
+}
+func (m *ListParticipantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListParticipantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListParticipantsRequest proto.InternalMessageInfo
+
+func (m *ListParticipantsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListParticipantsRequest) GetUniversity() string {
 	if m != nil {
 		return m.University
 	}
 	return ""
 }
 
-func (m *Participant) GetMajor() string {
+func (m *ListParticipantsRequest) GetMajor() string {
 	if m != nil {
 		return m.Major
 	}
 	return ""
 }
 
-func (m *Participant) GetGradYear() int32 {
+func (m *ListParticipantsRequest) GetGradYearMin() int32 {
 	if m != nil {
-		return m.GradYear
+		return m.GradYearMin
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*GetSponsorRequest)(nil), "proto.GetSponsorRequest")
-	proto.RegisterType((*GetSponsorResponse)(nil), "proto.GetSponsorResponse")
-	proto.RegisterType((*ResumesRequest)(nil), "proto.ResumesRequest")
-	proto.RegisterType((*ResumesResponse)(nil), "proto.ResumesResponse")
-	proto.RegisterType((*ListCompaniesResponse)(nil), "proto.ListCompaniesResponse")
-	proto.RegisterType((*ListCompaniesRequest)(nil), "proto.ListCompaniesRequest")
-	proto.RegisterType((*LoginSponsorRequest)(nil), "proto.LoginSponsorRequest")
-	proto.RegisterType((*LoginSponsorResponse)(nil), "proto.LoginSponsorResponse")
-	proto.RegisterType((*ListParticipantsRequest)(nil), "proto.ListParticipantsRequest")
-	proto.RegisterType((*ListParticipantsResponse)(nil), "proto.ListParticipantsResponse")
-	proto.RegisterType((*UpdateSponsorRequest)(nil), "proto.UpdateSponsorRequest")
-	proto.RegisterType((*UpdateSponsorResponse)(nil), "proto.UpdateSponsorResponse")
-	proto.RegisterType((*UpdateAdminRequest)(nil), "proto.UpdateAdminRequest")
-	proto.RegisterType((*UpdateAdminResponse)(nil), "proto.UpdateAdminResponse")
-	proto.RegisterType((*CreateSponsorRequest)(nil), "proto.CreateSponsorRequest")
-	proto.RegisterType((*CreateSponsorResponse)(nil), "proto.CreateSponsorResponse")
-	proto.RegisterType((*CreateCompanyRequest)(nil), "proto.CreateCompanyRequest")
-	proto.RegisterType((*CreateCompanyResponse)(nil), "proto.CreateCompanyResponse")
-	proto.RegisterType((*LoginAdminRequest)(nil), "proto.LoginAdminRequest")
-	proto.RegisterType((*LoginAdminResponse)(nil), "proto.LoginAdminResponse")
-	proto.RegisterType((*DeleteAdminRequest)(nil), "proto.DeleteAdminRequest")
-	proto.RegisterType((*DeleteAdminResponse)(nil), "proto.DeleteAdminResponse")
-	proto.RegisterType((*CreateAdminRequest)(nil), "proto.CreateAdminRequest")
-	proto.RegisterType((*CreateAdminResponse)(nil), "proto.CreateAdminResponse")
-	proto.RegisterType((*GetAdminRequest)(nil), "proto.GetAdminRequest")
-	proto.RegisterType((*GetAdminResponse)(nil), "proto.GetAdminResponse")
-	proto.RegisterType((*Admin)(nil), "proto.Admin")
-	proto.RegisterType((*Sponsor)(nil), "proto.Sponsor")
-	proto.RegisterType((*Company)(nil), "proto.Company")
-	proto.RegisterType((*Participant)(nil), "proto.Participant")
+func (m *ListParticipantsRequest) GetGradYearMax() int32 {
+	if m != nil {
+		return m.GradYearMax
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("sponsor.proto", fileDescriptor_b93c2e50814def43) }
+func (m *ListParticipantsRequest) GetHasResume() bool {
+	if m != nil {
+		return m.HasResume
+	}
+	return false
+}
 
-var fileDescriptor_b93c2e50814def43 = []byte{
-	// 1096 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0x97, 0x9d, 0x3a, 0xb6, 0xc7, 0x49, 0x9a, 0xac, 0xff, 0xe4, 0x72, 0x71, 0xd2, 0xb0, 0x50,
-	0x88, 0x4a, 0x94, 0x13, 0x41, 0xaa, 0x54, 0x1e, 0x2a, 0xac, 0x50, 0x55, 0x95, 0x22, 0x54, 0x39,
-	0x80, 0x84, 0x78, 0xb0, 0x36, 0xf1, 0xe2, 0x6c, 0x62, 0xdf, 0x9a, 0xbb, 0x73, 0x68, 0x54, 0xf5,
-	0x85, 0xaf, 0xc0, 0x23, 0x1f, 0x8b, 0xaf, 0x80, 0x78, 0xe7, 0x1b, 0xa0, 0xdb, 0x9b, 0xbd, 0xdb,
-	0xfb, 0x63, 0x63, 0x3f, 0xf0, 0x64, 0xef, 0xce, 0xcc, 0xef, 0x37, 0x33, 0x3b, 0xb3, 0x3b, 0x07,
-	0x9b, 0xfe, 0x54, 0xba, 0xbe, 0xf4, 0x4e, 0xa7, 0x9e, 0x0c, 0x24, 0xa9, 0xa8, 0x1f, 0xbb, 0x3b,
-	0x92, 0x72, 0x34, 0xe6, 0x0e, 0x9b, 0x0a, 0x87, 0xb9, 0xae, 0x0c, 0x58, 0x20, 0xa4, 0xeb, 0x47,
-	0x4a, 0xf4, 0x0c, 0x76, 0x5e, 0xf3, 0xe0, 0x32, 0x32, 0xec, 0xf3, 0x5f, 0x66, 0xdc, 0x0f, 0xc8,
-	0x01, 0x00, 0x42, 0x0d, 0xc4, 0xd0, 0x2a, 0x1d, 0x95, 0x8e, 0xeb, 0xfd, 0x3a, 0xee, 0xbc, 0x19,
-	0xd2, 0x97, 0x40, 0x4c, 0x1b, 0x25, 0xe0, 0xe4, 0x18, 0xaa, 0xa8, 0xa2, 0x2c, 0x1a, 0x67, 0x5b,
-	0x11, 0xc5, 0xa9, 0x56, 0xd4, 0x62, 0xfa, 0x29, 0x6c, 0xf5, 0xb9, 0x3f, 0x9b, 0x70, 0x5f, 0x13,
-	0xb6, 0xa0, 0x32, 0x16, 0x13, 0x11, 0x28, 0xcb, 0x4a, 0x3f, 0x5a, 0xd0, 0xcf, 0xe1, 0x71, 0xac,
-	0x87, 0x24, 0x16, 0x54, 0x99, 0x77, 0x7d, 0x23, 0xee, 0xb9, 0x52, 0xdd, 0xe8, 0xeb, 0x25, 0x7d,
-	0x05, 0xed, 0x0b, 0xe1, 0x07, 0xe7, 0x72, 0x32, 0x65, 0xae, 0x30, 0x4c, 0x4e, 0xa0, 0x7e, 0xad,
-	0x37, 0xad, 0xd2, 0xd1, 0x9a, 0xe1, 0x59, 0xa4, 0xfc, 0xd0, 0x4f, 0x14, 0xe8, 0x09, 0xb4, 0x32,
-	0x30, 0x8b, 0x3c, 0xfc, 0x09, 0x9a, 0x17, 0x72, 0x24, 0xdc, 0x4c, 0xfe, 0x5a, 0x50, 0xe1, 0x13,
-	0x26, 0xc6, 0x98, 0xba, 0x68, 0x41, 0x4e, 0xa1, 0x39, 0x65, 0xbe, 0xff, 0xab, 0xf4, 0x86, 0x83,
-	0xe9, 0x98, 0x09, 0x77, 0x10, 0xf0, 0x77, 0x81, 0x55, 0x56, 0x3a, 0x3b, 0x5a, 0xf4, 0x36, 0x94,
-	0x7c, 0xc7, 0xdf, 0x05, 0xf4, 0x07, 0x68, 0xa5, 0xc1, 0x31, 0xa0, 0x16, 0x54, 0x02, 0x79, 0xc7,
-	0x5d, 0x8d, 0xae, 0x16, 0x66, 0xfa, 0xcb, 0x8b, 0xd3, 0xef, 0xc0, 0x6e, 0x18, 0xe2, 0x5b, 0xe6,
-	0x05, 0xe2, 0x5a, 0x4c, 0x99, 0x1b, 0xfc, 0x47, 0x94, 0x7d, 0xb0, 0xf2, 0x06, 0xe8, 0xcc, 0x73,
-	0xd8, 0x98, 0x1a, 0xfb, 0x98, 0x60, 0x82, 0xdc, 0x86, 0x49, 0x3f, 0xa5, 0x47, 0x07, 0xd0, 0xfa,
-	0x7e, 0x3a, 0x64, 0x01, 0x5f, 0xa9, 0xf4, 0x56, 0x88, 0xb2, 0x07, 0xed, 0x0c, 0xc1, 0xca, 0x75,
-	0x7a, 0x09, 0x24, 0x82, 0xe8, 0x0d, 0x27, 0xc2, 0xd5, 0x1e, 0xee, 0x41, 0x8d, 0x85, 0xeb, 0xc4,
-	0xbf, 0xaa, 0x5a, 0xbf, 0x19, 0x12, 0x0a, 0x15, 0xf5, 0x17, 0x7d, 0xdb, 0x40, 0xe0, 0xc8, 0x3c,
-	0x12, 0xd1, 0x17, 0xd0, 0x4c, 0x81, 0xa2, 0x57, 0xb1, 0x69, 0x69, 0xbe, 0xe9, 0xd7, 0xd0, 0x3a,
-	0xf7, 0x78, 0x3e, 0x67, 0xcb, 0x47, 0xd4, 0x83, 0x76, 0x06, 0x61, 0xe5, 0xa4, 0xbc, 0xd4, 0x4e,
-	0xe8, 0xe6, 0x41, 0x27, 0x08, 0x3c, 0x72, 0xd9, 0x84, 0x63, 0x4a, 0xd4, 0xff, 0x70, 0x6f, 0x2c,
-	0x47, 0x12, 0x4b, 0x5c, 0xfd, 0x4f, 0x5c, 0x88, 0xed, 0x13, 0x17, 0xa2, 0x36, 0x7c, 0xc8, 0xb8,
-	0xa0, 0x15, 0xb5, 0x98, 0xbe, 0x82, 0x1d, 0xd5, 0x18, 0xa9, 0x63, 0x29, 0xee, 0x39, 0x1b, 0x6a,
-	0xba, 0xb1, 0xd0, 0x8b, 0x78, 0x4d, 0xbf, 0x05, 0x62, 0xc2, 0x2c, 0xec, 0xae, 0x65, 0x4e, 0xd6,
-	0x01, 0xf2, 0x0d, 0x1f, 0xf3, 0xa5, 0xcb, 0x85, 0x3e, 0x85, 0x66, 0xca, 0x00, 0x3d, 0xd8, 0x82,
-	0xb2, 0xbc, 0x53, 0xba, 0xb5, 0x7e, 0x59, 0xde, 0x51, 0x17, 0x48, 0x94, 0xb1, 0x14, 0x6e, 0x51,
-	0xbe, 0xe3, 0x1c, 0x94, 0x97, 0xb8, 0x77, 0xd6, 0xe6, 0xdd, 0x3b, 0x2f, 0xa0, 0x99, 0xe2, 0x5b,
-	0xa1, 0x42, 0x4f, 0xe0, 0xf1, 0x6b, 0x1e, 0x2c, 0x1b, 0xff, 0x73, 0xd8, 0x4e, 0xb4, 0x57, 0x60,
-	0x91, 0x50, 0x51, 0xeb, 0x30, 0x53, 0x31, 0x6a, 0x59, 0x0c, 0xe3, 0x9c, 0x94, 0x8b, 0x72, 0xb2,
-	0x36, 0xaf, 0x2e, 0x1e, 0xa5, 0xeb, 0x82, 0x6c, 0xc3, 0x5a, 0xef, 0xfc, 0xc2, 0xaa, 0xa8, 0xed,
-	0xf0, 0x2f, 0xfd, 0xa3, 0x04, 0x55, 0x6c, 0x84, 0xff, 0x89, 0xd3, 0x28, 0xfe, 0xca, 0xc2, 0xe2,
-	0xd7, 0xde, 0xad, 0x27, 0xde, 0xf5, 0xa0, 0x8a, 0x5a, 0x4b, 0x39, 0xa7, 0x9b, 0x72, 0xcd, 0x68,
-	0xca, 0xbf, 0x4b, 0xd0, 0x30, 0xee, 0xea, 0xa5, 0x70, 0x3a, 0xb0, 0x3e, 0x12, 0xc1, 0xcd, 0xec,
-	0x0a, 0x91, 0x70, 0x15, 0x86, 0x39, 0x16, 0xee, 0x1d, 0x1f, 0x0a, 0x57, 0x87, 0xa9, 0xd7, 0xa1,
-	0x8d, 0xa7, 0x5e, 0x74, 0xcc, 0x2e, 0xae, 0x92, 0x84, 0xad, 0x9b, 0x09, 0x3b, 0x04, 0x98, 0xb9,
-	0xe2, 0x9e, 0x7b, 0xbe, 0x08, 0x1e, 0xac, 0xaa, 0x12, 0x19, 0x3b, 0xa1, 0xd5, 0x84, 0xdd, 0x4a,
-	0xcf, 0xaa, 0x45, 0x56, 0x6a, 0x41, 0xf6, 0xa1, 0x3e, 0xf2, 0xd8, 0x70, 0xf0, 0xc0, 0x99, 0x67,
-	0xd5, 0xd5, 0x3b, 0x56, 0x0b, 0x37, 0x7e, 0xe4, 0xcc, 0x3b, 0xfb, 0x07, 0x60, 0x0b, 0x4f, 0xf2,
-	0x92, 0x7b, 0xf7, 0xe2, 0x9a, 0x93, 0x2b, 0x68, 0x18, 0xe5, 0x4e, 0xf6, 0x74, 0xe2, 0x73, 0x2d,
-	0x67, 0xdb, 0x45, 0xa2, 0xa8, 0x6e, 0x69, 0xf7, 0xb7, 0x3f, 0xff, 0xfa, 0xbd, 0xdc, 0xa1, 0x3b,
-	0xce, 0xfd, 0x17, 0x0e, 0xde, 0x95, 0x8e, 0x2a, 0xd7, 0xaf, 0x4a, 0xcf, 0x08, 0x83, 0x9a, 0xae,
-	0x74, 0xd2, 0x41, 0x94, 0x4c, 0xa3, 0xd8, 0xbb, 0xb9, 0x7d, 0x84, 0xfe, 0x44, 0x41, 0x1f, 0x92,
-	0x6e, 0x0e, 0xda, 0x79, 0xaf, 0x5b, 0xeb, 0x03, 0xb9, 0x85, 0x86, 0x71, 0x99, 0xc4, 0x61, 0xe4,
-	0x6f, 0xa4, 0x38, 0x8c, 0x82, 0xbb, 0x47, 0x73, 0x3d, 0x5b, 0xcc, 0x35, 0x81, 0x86, 0xf1, 0x86,
-	0xc5, 0x5c, 0xf9, 0xc7, 0x32, 0xe6, 0x2a, 0x78, 0xf2, 0xe8, 0x67, 0x8a, 0xeb, 0x23, 0x7b, 0x21,
-	0x57, 0x98, 0x3d, 0x0e, 0x90, 0x5c, 0xd4, 0xc4, 0x42, 0xc8, 0xdc, 0x13, 0x60, 0xef, 0x15, 0x48,
-	0x90, 0x8b, 0x2a, 0xae, 0x2e, 0xdd, 0xcd, 0x73, 0x8d, 0x43, 0xed, 0x90, 0xe6, 0x0a, 0x36, 0x53,
-	0x8f, 0x23, 0xd9, 0x4f, 0x9d, 0x77, 0xfa, 0xd1, 0xb5, 0xbb, 0xc5, 0x42, 0xe4, 0xeb, 0x28, 0xbe,
-	0x6d, 0xda, 0x30, 0xf8, 0x42, 0x8e, 0x1b, 0x80, 0x64, 0x74, 0x8e, 0x43, 0xc9, 0x4d, 0xe0, 0x71,
-	0x28, 0xf9, 0x39, 0x9b, 0x3e, 0x55, 0xd0, 0x4f, 0xc8, 0x81, 0x19, 0xca, 0xfb, 0x64, 0x68, 0xfa,
-	0xe0, 0x08, 0xf7, 0x67, 0x49, 0x24, 0x6c, 0xa6, 0xe6, 0x9f, 0x38, 0x9a, 0xa2, 0xb1, 0x2b, 0x8e,
-	0xa6, 0x70, 0x64, 0xa2, 0x1f, 0x2b, 0xca, 0x03, 0xdb, 0x9a, 0x47, 0x19, 0x86, 0x76, 0xab, 0xd3,
-	0xa7, 0x2f, 0xa3, 0x74, 0xfa, 0xd2, 0xe3, 0x42, 0x26, 0x7d, 0x99, 0x59, 0x80, 0x1e, 0x2a, 0x42,
-	0x8b, 0x36, 0x4d, 0x42, 0xbc, 0x01, 0xa3, 0x8a, 0xd8, 0x30, 0x47, 0x63, 0x62, 0x9b, 0x27, 0x9f,
-	0x09, 0x6d, 0xbf, 0x50, 0xb6, 0xa8, 0x6d, 0x8d, 0x8a, 0xa8, 0xe2, 0x07, 0x08, 0x69, 0x23, 0x4a,
-	0xfa, 0xc3, 0xc5, 0xee, 0x64, 0xb7, 0x11, 0xf7, 0x58, 0xe1, 0x52, 0x72, 0x64, 0xe2, 0x9a, 0x03,
-	0xb0, 0xe3, 0x21, 0xf0, 0x0c, 0xb6, 0xb3, 0xc3, 0x35, 0x39, 0xd4, 0x2e, 0x17, 0x8f, 0xe9, 0xf6,
-	0x93, 0xb9, 0x72, 0xa4, 0x3f, 0x52, 0xf4, 0x36, 0xb1, 0xe6, 0xd1, 0x13, 0x01, 0x9b, 0xa9, 0xef,
-	0x9c, 0xf8, 0xb4, 0x8a, 0xbe, 0x7e, 0xe2, 0xd3, 0x2a, 0xfc, 0xc2, 0xa2, 0x07, 0x8a, 0x6d, 0x97,
-	0xb4, 0xf3, 0xa7, 0x25, 0xb8, 0x7f, 0xb5, 0xae, 0x6c, 0xbf, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0xec, 0x34, 0x45, 0x6a, 0x9f, 0x0e, 0x00, 0x00,
+func (m *ListParticipantsRequest) GetHasGithub() bool {
+	if m != nil {
+		return m.HasGithub
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ListParticipantsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ListParticipantsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
 
-// SponsorServiceClient is the client API for SponsorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SponsorServiceClient interface {
-	//
-	//================================================================
-	//ADMIN RPC CALLS
-	//================================================================
-	CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error)
-	GetAdmin(ctx context.Context, in *GetAdminRequest, opts ...grpc.CallOption) (*GetAdminResponse, error)
-	DeleteAdmin(ctx context.Context, in *DeleteAdminRequest, opts ...grpc.CallOption) (*DeleteAdminResponse, error)
-	UpdateAdmin(ctx context.Context, in *UpdateAdminRequest, opts ...grpc.CallOption) (*UpdateAdminResponse, error)
-	LoginAdmin(ctx context.Context, in *LoginAdminRequest, opts ...grpc.CallOption) (*LoginAdminResponse, error)
-	//
-	//================================================================
-	//SPONSOR RPC CALLS
-	//================================================================
-	CreateSponsor(ctx context.Context, in *CreateSponsorRequest, opts ...grpc.CallOption) (*CreateSponsorResponse, error)
-	GetSponsor(ctx context.Context, in *GetSponsorRequest, opts ...grpc.CallOption) (*GetSponsorResponse, error)
-	UpdateSponsor(ctx context.Context, in *UpdateSponsorRequest, opts ...grpc.CallOption) (*UpdateSponsorResponse, error)
-	CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*CreateCompanyResponse, error)
-	LoginSponsor(ctx context.Context, in *LoginSponsorRequest, opts ...grpc.CallOption) (*LoginSponsorResponse, error)
-	Resumes(ctx context.Context, in *ResumesRequest, opts ...grpc.CallOption) (*ResumesResponse, error)
-	ListParticipants(ctx context.Context, in *ListParticipantsRequest, opts ...grpc.CallOption) (*ListParticipantsResponse, error)
-	ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error)
+func (m *ListParticipantsRequest) GetShortlisted() bool {
+	if m != nil {
+		return m.Shortlisted
+	}
+	return false
 }
 
-type sponsorServiceClient struct {
-	cc *grpc.ClientConn
+type ListParticipantsResponse struct {
+	Participants []*Participant `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	// next_page_token is empty when there are no more participants
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewSponsorServiceClient(cc *grpc.ClientConn) SponsorServiceClient {
-	return &sponsorServiceClient{cc}
+func (m *ListParticipantsResponse) Reset()         { *m = ListParticipantsResponse{} }
+func (m *ListParticipantsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListParticipantsResponse) ProtoMessage()    {}
+func (*ListParticipantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{34}
 }
 
-func (c *sponsorServiceClient) CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error) {
-	out := new(CreateAdminResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/CreateAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListParticipantsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListParticipantsResponse.Unmarshal(m, b)
+}
+func (m *ListParticipantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListParticipantsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListParticipantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListParticipantsResponse.Merge(m, src)
+}
+func (m *ListParticipantsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListParticipantsResponse.Size(m)
+}
+func (m *ListParticipantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListParticipantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListParticipantsResponse proto.InternalMessageInfo
+
+func (m *ListParticipantsResponse) GetParticipants() []*Participant {
+	if m != nil {
+		return m.Participants
 	}
-	return out, nil
+	return nil
 }
 
-func (c *sponsorServiceClient) GetAdmin(ctx context.Context, in *GetAdminRequest, opts ...grpc.CallOption) (*GetAdminResponse, error) {
-	out := new(GetAdminResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/GetAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListParticipantsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return out, nil
+	return ""
 }
 
-func (c *sponsorServiceClient) DeleteAdmin(ctx context.Context, in *DeleteAdminRequest, opts ...grpc.CallOption) (*DeleteAdminResponse, error) {
-	out := new(DeleteAdminResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/DeleteAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+type SearchParticipantsRequest struct {
+	// query is a list of keywords e.g. "rust internship", all the keywords
+	// have to match
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// limit is an optional field, at most 100 results are returned
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the filters are the same as the filters of ListParticipantsRequest
+	University           string   `protobuf:"bytes,3,opt,name=university,proto3" json:"university,omitempty"`
+	Major                string   `protobuf:"bytes,4,opt,name=major,proto3" json:"major,omitempty"`
+	GradYearMin          int32    `protobuf:"varint,5,opt,name=grad_year_min,json=gradYearMin,proto3" json:"grad_year_min,omitempty"`
+	GradYearMax          int32    `protobuf:"varint,6,opt,name=grad_year_max,json=gradYearMax,proto3" json:"grad_year_max,omitempty"`
+	Shortlisted          bool     `protobuf:"varint,7,opt,name=shortlisted,proto3" json:"shortlisted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchParticipantsRequest) Reset()         { *m = SearchParticipantsRequest{} }
+func (m *SearchParticipantsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchParticipantsRequest) ProtoMessage()    {}
+func (*SearchParticipantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{35}
+}
+
+func (m *SearchParticipantsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchParticipantsRequest.Unmarshal(m, b)
+}
+func (m *SearchParticipantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchParticipantsRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchParticipantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchParticipantsRequest.Merge(m, src)
+}
+func (m *SearchParticipantsRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchParticipantsRequest.Size(m)
+}
+func (m *SearchParticipantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchParticipantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchParticipantsRequest proto.InternalMessageInfo
+
+func (m *SearchParticipantsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	return out, nil
+	return ""
 }
 
-func (c *sponsorServiceClient) UpdateAdmin(ctx context.Context, in *UpdateAdminRequest, opts ...grpc.CallOption) (*UpdateAdminResponse, error) {
-	out := new(UpdateAdminResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/UpdateAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SearchParticipantsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *sponsorServiceClient) LoginAdmin(ctx context.Context, in *LoginAdminRequest, opts ...grpc.CallOption) (*LoginAdminResponse, error) {
-	out := new(LoginAdminResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/LoginAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SearchParticipantsRequest) GetUniversity() string {
+	if m != nil {
+		return m.University
 	}
-	return out, nil
+	return ""
 }
 
-func (c *sponsorServiceClient) CreateSponsor(ctx context.Context, in *CreateSponsorRequest, opts ...grpc.CallOption) (*CreateSponsorResponse, error) {
-	out := new(CreateSponsorResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/CreateSponsor", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SearchParticipantsRequest) GetMajor() string {
+	if m != nil {
+		return m.Major
 	}
-	return out, nil
+	return ""
+}
+
+func (m *SearchParticipantsRequest) GetGradYearMin() int32 {
+	if m != nil {
+		return m.GradYearMin
+	}
+	return 0
+}
+
+func (m *SearchParticipantsRequest) GetGradYearMax() int32 {
+	if m != nil {
+		return m.GradYearMax
+	}
+	return 0
+}
+
+func (m *SearchParticipantsRequest) GetShortlisted() bool {
+	if m != nil {
+		return m.Shortlisted
+	}
+	return false
+}
+
+type SearchParticipantsResponse struct {
+	Results              []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SearchParticipantsResponse) Reset()         { *m = SearchParticipantsResponse{} }
+func (m *SearchParticipantsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchParticipantsResponse) ProtoMessage()    {}
+func (*SearchParticipantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{36}
+}
+
+func (m *SearchParticipantsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchParticipantsResponse.Unmarshal(m, b)
+}
+func (m *SearchParticipantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchParticipantsResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchParticipantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchParticipantsResponse.Merge(m, src)
+}
+func (m *SearchParticipantsResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchParticipantsResponse.Size(m)
+}
+func (m *SearchParticipantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchParticipantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchParticipantsResponse proto.InternalMessageInfo
+
+func (m *SearchParticipantsResponse) GetResults() []*SearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type SearchResult struct {
+	Participant *Participant `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	Rank        float32      `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// snippet is a plain text excerpt of the resume with the matched
+	// keywords wrapped in « and », it is not HTML
+	Snippet              string   `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchResult) Reset()         { *m = SearchResult{} }
+func (m *SearchResult) String() string { return proto.CompactTextString(m) }
+func (*SearchResult) ProtoMessage()    {}
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{37}
+}
+
+func (m *SearchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResult.Unmarshal(m, b)
+}
+func (m *SearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResult.Marshal(b, m, deterministic)
+}
+func (m *SearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResult.Merge(m, src)
+}
+func (m *SearchResult) XXX_Size() int {
+	return xxx_messageInfo_SearchResult.Size(m)
+}
+func (m *SearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResult proto.InternalMessageInfo
+
+func (m *SearchResult) GetParticipant() *Participant {
+	if m != nil {
+		return m.Participant
+	}
+	return nil
+}
+
+func (m *SearchResult) GetRank() float32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *SearchResult) GetSnippet() string {
+	if m != nil {
+		return m.Snippet
+	}
+	return ""
+}
+
+type UpdateSponsorRequest struct {
+	SponsorId            string   `protobuf:"bytes,1,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`
+	Sponsor              *Sponsor `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateSponsorRequest) Reset()         { *m = UpdateSponsorRequest{} }
+func (m *UpdateSponsorRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateSponsorRequest) ProtoMessage()    {}
+func (*UpdateSponsorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{38}
+}
+
+func (m *UpdateSponsorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSponsorRequest.Unmarshal(m, b)
+}
+func (m *UpdateSponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSponsorRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateSponsorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSponsorRequest.Merge(m, src)
+}
+func (m *UpdateSponsorRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateSponsorRequest.Size(m)
+}
+func (m *UpdateSponsorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSponsorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSponsorRequest proto.InternalMessageInfo
+
+func (m *UpdateSponsorRequest) GetSponsorId() string {
+	if m != nil {
+		return m.SponsorId
+	}
+	return ""
+}
+
+func (m *UpdateSponsorRequest) GetSponsor() *Sponsor {
+	if m != nil {
+		return m.Sponsor
+	}
+	return nil
+}
+
+type UpdateSponsorResponse struct {
+	Sponsor              *Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateSponsorResponse) Reset()         { *m = UpdateSponsorResponse{} }
+func (m *UpdateSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateSponsorResponse) ProtoMessage()    {}
+func (*UpdateSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{39}
+}
+
+func (m *UpdateSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSponsorResponse.Unmarshal(m, b)
+}
+func (m *UpdateSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSponsorResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSponsorResponse.Merge(m, src)
+}
+func (m *UpdateSponsorResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateSponsorResponse.Size(m)
+}
+func (m *UpdateSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSponsorResponse proto.InternalMessageInfo
+
+func (m *UpdateSponsorResponse) GetSponsor() *Sponsor {
+	if m != nil {
+		return m.Sponsor
+	}
+	return nil
+}
+
+type UpdateAdminRequest struct {
+	AdminId              string   `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Admin                *Admin   `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateAdminRequest) Reset()         { *m = UpdateAdminRequest{} }
+func (m *UpdateAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAdminRequest) ProtoMessage()    {}
+func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{40}
+}
+
+func (m *UpdateAdminRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAdminRequest.Unmarshal(m, b)
+}
+func (m *UpdateAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAdminRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAdminRequest.Merge(m, src)
+}
+func (m *UpdateAdminRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateAdminRequest.Size(m)
+}
+func (m *UpdateAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAdminRequest proto.InternalMessageInfo
+
+func (m *UpdateAdminRequest) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
+	}
+	return ""
+}
+
+func (m *UpdateAdminRequest) GetAdmin() *Admin {
+	if m != nil {
+		return m.Admin
+	}
+	return nil
+}
+
+type UpdateAdminResponse struct {
+	Admin                *Admin   `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateAdminResponse) Reset()         { *m = UpdateAdminResponse{} }
+func (m *UpdateAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateAdminResponse) ProtoMessage()    {}
+func (*UpdateAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{41}
+}
+
+func (m *UpdateAdminResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAdminResponse.Unmarshal(m, b)
+}
+func (m *UpdateAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAdminResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAdminResponse.Merge(m, src)
+}
+func (m *UpdateAdminResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateAdminResponse.Size(m)
+}
+func (m *UpdateAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAdminResponse proto.InternalMessageInfo
+
+func (m *UpdateAdminResponse) GetAdmin() *Admin {
+	if m != nil {
+		return m.Admin
+	}
+	return nil
+}
+
+type CreateSponsorRequest struct {
+	Sponsor              *Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSponsorRequest) Reset()         { *m = CreateSponsorRequest{} }
+func (m *CreateSponsorRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSponsorRequest) ProtoMessage()    {}
+func (*CreateSponsorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{42}
+}
+
+func (m *CreateSponsorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSponsorRequest.Unmarshal(m, b)
+}
+func (m *CreateSponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSponsorRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateSponsorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSponsorRequest.Merge(m, src)
+}
+func (m *CreateSponsorRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateSponsorRequest.Size(m)
+}
+func (m *CreateSponsorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSponsorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSponsorRequest proto.InternalMessageInfo
+
+func (m *CreateSponsorRequest) GetSponsor() *Sponsor {
+	if m != nil {
+		return m.Sponsor
+	}
+	return nil
+}
+
+type CreateSponsorResponse struct {
+	Sponsor *Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// invite_sent is set when the sponsor was created without a password and
+	// was emailed an invite to set one
+	InviteSent           bool     `protobuf:"varint,2,opt,name=invite_sent,json=inviteSent,proto3" json:"invite_sent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSponsorResponse) Reset()         { *m = CreateSponsorResponse{} }
+func (m *CreateSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateSponsorResponse) ProtoMessage()    {}
+func (*CreateSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{43}
+}
+
+func (m *CreateSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSponsorResponse.Unmarshal(m, b)
+}
+func (m *CreateSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSponsorResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSponsorResponse.Merge(m, src)
+}
+func (m *CreateSponsorResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateSponsorResponse.Size(m)
+}
+func (m *CreateSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSponsorResponse proto.InternalMessageInfo
+
+func (m *CreateSponsorResponse) GetSponsor() *Sponsor {
+	if m != nil {
+		return m.Sponsor
+	}
+	return nil
+}
+
+func (m *CreateSponsorResponse) GetInviteSent() bool {
+	if m != nil {
+		return m.InviteSent
+	}
+	return false
+}
+
+type AcceptInviteRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptInviteRequest) Reset()         { *m = AcceptInviteRequest{} }
+func (m *AcceptInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptInviteRequest) ProtoMessage()    {}
+func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{44}
+}
+
+func (m *AcceptInviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptInviteRequest.Unmarshal(m, b)
+}
+func (m *AcceptInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptInviteRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptInviteRequest.Merge(m, src)
+}
+func (m *AcceptInviteRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptInviteRequest.Size(m)
+}
+func (m *AcceptInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptInviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptInviteRequest proto.InternalMessageInfo
+
+func (m *AcceptInviteRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *AcceptInviteRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type AcceptInviteResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptInviteResponse) Reset()         { *m = AcceptInviteResponse{} }
+func (m *AcceptInviteResponse) String() string { return proto.CompactTextString(m) }
+func (*AcceptInviteResponse) ProtoMessage()    {}
+func (*AcceptInviteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{45}
+}
+
+func (m *AcceptInviteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptInviteResponse.Unmarshal(m, b)
+}
+func (m *AcceptInviteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptInviteResponse.Marshal(b, m, deterministic)
+}
+func (m *AcceptInviteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptInviteResponse.Merge(m, src)
+}
+func (m *AcceptInviteResponse) XXX_Size() int {
+	return xxx_messageInfo_AcceptInviteResponse.Size(m)
+}
+func (m *AcceptInviteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptInviteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptInviteResponse proto.InternalMessageInfo
+
+func (m *AcceptInviteResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type RequestPasswordResetRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetRequest) Reset()         { *m = RequestPasswordResetRequest{} }
+func (m *RequestPasswordResetRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetRequest) ProtoMessage()    {}
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{46}
+}
+
+func (m *RequestPasswordResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetRequest.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestPasswordResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetRequest.Merge(m, src)
+}
+func (m *RequestPasswordResetRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetRequest.Size(m)
+}
+func (m *RequestPasswordResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetRequest proto.InternalMessageInfo
+
+func (m *RequestPasswordResetRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetResponse) Reset()         { *m = RequestPasswordResetResponse{} }
+func (m *RequestPasswordResetResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetResponse) ProtoMessage()    {}
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{47}
+}
+
+func (m *RequestPasswordResetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetResponse.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetResponse.Marshal(b, m, deterministic)
+}
+func (m *RequestPasswordResetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetResponse.Merge(m, src)
+}
+func (m *RequestPasswordResetResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetResponse.Size(m)
+}
+func (m *RequestPasswordResetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetResponse proto.InternalMessageInfo
+
+func (m *RequestPasswordResetResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type ResetPasswordRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordRequest) Reset()         { *m = ResetPasswordRequest{} }
+func (m *ResetPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordRequest) ProtoMessage()    {}
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{48}
+}
+
+func (m *ResetPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordRequest.Merge(m, src)
+}
+func (m *ResetPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordRequest.Size(m)
+}
+func (m *ResetPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetPasswordRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ResetPasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordResponse) Reset()         { *m = ResetPasswordResponse{} }
+func (m *ResetPasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordResponse) ProtoMessage()    {}
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{49}
+}
+
+func (m *ResetPasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordResponse.Unmarshal(m, b)
+}
+func (m *ResetPasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordResponse.Marshal(b, m, deterministic)
+}
+func (m *ResetPasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordResponse.Merge(m, src)
+}
+func (m *ResetPasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordResponse.Size(m)
+}
+func (m *ResetPasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordResponse proto.InternalMessageInfo
+
+func (m *ResetPasswordResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type CreateCompanyRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Logo string `protobuf:"bytes,2,opt,name=logo,proto3" json:"logo,omitempty"`
+	// tier is an optional field, the default tier is used when it is empty
+	Tier                 string   `protobuf:"bytes,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCompanyRequest) Reset()         { *m = CreateCompanyRequest{} }
+func (m *CreateCompanyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCompanyRequest) ProtoMessage()    {}
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{50}
+}
+
+func (m *CreateCompanyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCompanyRequest.Unmarshal(m, b)
+}
+func (m *CreateCompanyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCompanyRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCompanyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCompanyRequest.Merge(m, src)
+}
+func (m *CreateCompanyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCompanyRequest.Size(m)
+}
+func (m *CreateCompanyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCompanyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCompanyRequest proto.InternalMessageInfo
+
+func (m *CreateCompanyRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateCompanyRequest) GetLogo() string {
+	if m != nil {
+		return m.Logo
+	}
+	return ""
+}
+
+func (m *CreateCompanyRequest) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+type CreateCompanyResponse struct {
+	Company              *Company `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCompanyResponse) Reset()         { *m = CreateCompanyResponse{} }
+func (m *CreateCompanyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCompanyResponse) ProtoMessage()    {}
+func (*CreateCompanyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{51}
+}
+
+func (m *CreateCompanyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCompanyResponse.Unmarshal(m, b)
+}
+func (m *CreateCompanyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCompanyResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateCompanyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCompanyResponse.Merge(m, src)
+}
+func (m *CreateCompanyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCompanyResponse.Size(m)
+}
+func (m *CreateCompanyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCompanyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCompanyResponse proto.InternalMessageInfo
+
+func (m *CreateCompanyResponse) GetCompany() *Company {
+	if m != nil {
+		return m.Company
+	}
+	return nil
+}
+
+type GetCompanyRequest struct {
+	CompanyId            string   `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompanyRequest) Reset()         { *m = GetCompanyRequest{} }
+func (m *GetCompanyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompanyRequest) ProtoMessage()    {}
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{52}
+}
+
+func (m *GetCompanyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompanyRequest.Unmarshal(m, b)
+}
+func (m *GetCompanyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompanyRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompanyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompanyRequest.Merge(m, src)
+}
+func (m *GetCompanyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompanyRequest.Size(m)
+}
+func (m *GetCompanyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompanyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompanyRequest proto.InternalMessageInfo
+
+func (m *GetCompanyRequest) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
+	}
+	return ""
+}
+
+type GetCompanyResponse struct {
+	Company              *Company `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompanyResponse) Reset()         { *m = GetCompanyResponse{} }
+func (m *GetCompanyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompanyResponse) ProtoMessage()    {}
+func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{53}
+}
+
+func (m *GetCompanyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompanyResponse.Unmarshal(m, b)
+}
+func (m *GetCompanyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompanyResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompanyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompanyResponse.Merge(m, src)
+}
+func (m *GetCompanyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompanyResponse.Size(m)
+}
+func (m *GetCompanyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompanyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompanyResponse proto.InternalMessageInfo
+
+func (m *GetCompanyResponse) GetCompany() *Company {
+	if m != nil {
+		return m.Company
+	}
+	return nil
+}
+
+type UpdateCompanyRequest struct {
+	CompanyId            string   `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Company              *Company `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCompanyRequest) Reset()         { *m = UpdateCompanyRequest{} }
+func (m *UpdateCompanyRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCompanyRequest) ProtoMessage()    {}
+func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{54}
+}
+
+func (m *UpdateCompanyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCompanyRequest.Unmarshal(m, b)
+}
+func (m *UpdateCompanyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCompanyRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCompanyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCompanyRequest.Merge(m, src)
+}
+func (m *UpdateCompanyRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCompanyRequest.Size(m)
+}
+func (m *UpdateCompanyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCompanyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCompanyRequest proto.InternalMessageInfo
+
+func (m *UpdateCompanyRequest) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
+	}
+	return ""
+}
+
+func (m *UpdateCompanyRequest) GetCompany() *Company {
+	if m != nil {
+		return m.Company
+	}
+	return nil
+}
+
+type UpdateCompanyResponse struct {
+	Company              *Company `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCompanyResponse) Reset()         { *m = UpdateCompanyResponse{} }
+func (m *UpdateCompanyResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateCompanyResponse) ProtoMessage()    {}
+func (*UpdateCompanyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{55}
+}
+
+func (m *UpdateCompanyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCompanyResponse.Unmarshal(m, b)
+}
+func (m *UpdateCompanyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCompanyResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateCompanyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCompanyResponse.Merge(m, src)
+}
+func (m *UpdateCompanyResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateCompanyResponse.Size(m)
+}
+func (m *UpdateCompanyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCompanyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCompanyResponse proto.InternalMessageInfo
+
+func (m *UpdateCompanyResponse) GetCompany() *Company {
+	if m != nil {
+		return m.Company
+	}
+	return nil
+}
+
+type SetCompanyTierRequest struct {
+	CompanyId            string   `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Tier                 string   `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetCompanyTierRequest) Reset()         { *m = SetCompanyTierRequest{} }
+func (m *SetCompanyTierRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCompanyTierRequest) ProtoMessage()    {}
+func (*SetCompanyTierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{56}
+}
+
+func (m *SetCompanyTierRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCompanyTierRequest.Unmarshal(m, b)
+}
+func (m *SetCompanyTierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCompanyTierRequest.Marshal(b, m, deterministic)
+}
+func (m *SetCompanyTierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCompanyTierRequest.Merge(m, src)
+}
+func (m *SetCompanyTierRequest) XXX_Size() int {
+	return xxx_messageInfo_SetCompanyTierRequest.Size(m)
+}
+func (m *SetCompanyTierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCompanyTierRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCompanyTierRequest proto.InternalMessageInfo
+
+func (m *SetCompanyTierRequest) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
+	}
+	return ""
+}
+
+func (m *SetCompanyTierRequest) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+type SetCompanyTierResponse struct {
+	Company              *Company `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetCompanyTierResponse) Reset()         { *m = SetCompanyTierResponse{} }
+func (m *SetCompanyTierResponse) String() string { return proto.CompactTextString(m) }
+func (*SetCompanyTierResponse) ProtoMessage()    {}
+func (*SetCompanyTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{57}
+}
+
+func (m *SetCompanyTierResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCompanyTierResponse.Unmarshal(m, b)
+}
+func (m *SetCompanyTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCompanyTierResponse.Marshal(b, m, deterministic)
+}
+func (m *SetCompanyTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCompanyTierResponse.Merge(m, src)
+}
+func (m *SetCompanyTierResponse) XXX_Size() int {
+	return xxx_messageInfo_SetCompanyTierResponse.Size(m)
+}
+func (m *SetCompanyTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCompanyTierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCompanyTierResponse proto.InternalMessageInfo
+
+func (m *SetCompanyTierResponse) GetCompany() *Company {
+	if m != nil {
+		return m.Company
+	}
+	return nil
+}
+
+type ListCompanySponsorsRequest struct {
+	CompanyId string `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// limit is an optional field
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// page_token is the next_page_token of a previous response
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCompanySponsorsRequest) Reset()         { *m = ListCompanySponsorsRequest{} }
+func (m *ListCompanySponsorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCompanySponsorsRequest) ProtoMessage()    {}
+func (*ListCompanySponsorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{58}
+}
+
+func (m *ListCompanySponsorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCompanySponsorsRequest.Unmarshal(m, b)
+}
+func (m *ListCompanySponsorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCompanySponsorsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCompanySponsorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCompanySponsorsRequest.Merge(m, src)
+}
+func (m *ListCompanySponsorsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCompanySponsorsRequest.Size(m)
+}
+func (m *ListCompanySponsorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCompanySponsorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCompanySponsorsRequest proto.InternalMessageInfo
+
+func (m *ListCompanySponsorsRequest) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
+	}
+	return ""
+}
+
+func (m *ListCompanySponsorsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListCompanySponsorsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListCompanySponsorsResponse struct {
+	Sponsors []*Sponsor `protobuf:"bytes,1,rep,name=sponsors,proto3" json:"sponsors,omitempty"`
+	// next_page_token is empty when there are no more sponsors
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCompanySponsorsResponse) Reset()         { *m = ListCompanySponsorsResponse{} }
+func (m *ListCompanySponsorsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCompanySponsorsResponse) ProtoMessage()    {}
+func (*ListCompanySponsorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{59}
+}
+
+func (m *ListCompanySponsorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCompanySponsorsResponse.Unmarshal(m, b)
+}
+func (m *ListCompanySponsorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCompanySponsorsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCompanySponsorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCompanySponsorsResponse.Merge(m, src)
+}
+func (m *ListCompanySponsorsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCompanySponsorsResponse.Size(m)
+}
+func (m *ListCompanySponsorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCompanySponsorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCompanySponsorsResponse proto.InternalMessageInfo
+
+func (m *ListCompanySponsorsResponse) GetSponsors() []*Sponsor {
+	if m != nil {
+		return m.Sponsors
+	}
+	return nil
+}
+
+func (m *ListCompanySponsorsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type LoginAdminRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoginAdminRequest) Reset()         { *m = LoginAdminRequest{} }
+func (m *LoginAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*LoginAdminRequest) ProtoMessage()    {}
+func (*LoginAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{60}
+}
+
+func (m *LoginAdminRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginAdminRequest.Unmarshal(m, b)
+}
+func (m *LoginAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginAdminRequest.Marshal(b, m, deterministic)
+}
+func (m *LoginAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginAdminRequest.Merge(m, src)
+}
+func (m *LoginAdminRequest) XXX_Size() int {
+	return xxx_messageInfo_LoginAdminRequest.Size(m)
+}
+func (m *LoginAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginAdminRequest proto.InternalMessageInfo
+
+func (m *LoginAdminRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *LoginAdminRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type LoginAdminResponse struct {
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Admin        *Admin `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	RefreshToken string `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// expires_at is the unix time at which the access token expires
+	ExpiresAt            int64    `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoginAdminResponse) Reset()         { *m = LoginAdminResponse{} }
+func (m *LoginAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*LoginAdminResponse) ProtoMessage()    {}
+func (*LoginAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{61}
+}
+
+func (m *LoginAdminResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginAdminResponse.Unmarshal(m, b)
+}
+func (m *LoginAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginAdminResponse.Marshal(b, m, deterministic)
+}
+func (m *LoginAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginAdminResponse.Merge(m, src)
+}
+func (m *LoginAdminResponse) XXX_Size() int {
+	return xxx_messageInfo_LoginAdminResponse.Size(m)
+}
+func (m *LoginAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginAdminResponse proto.InternalMessageInfo
+
+func (m *LoginAdminResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *LoginAdminResponse) GetAdmin() *Admin {
+	if m != nil {
+		return m.Admin
+	}
+	return nil
+}
+
+func (m *LoginAdminResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *LoginAdminResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type DeleteAdminRequest struct {
+	AdminId              string   `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAdminRequest) Reset()         { *m = DeleteAdminRequest{} }
+func (m *DeleteAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAdminRequest) ProtoMessage()    {}
+func (*DeleteAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{62}
+}
+
+func (m *DeleteAdminRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAdminRequest.Unmarshal(m, b)
+}
+func (m *DeleteAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAdminRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAdminRequest.Merge(m, src)
+}
+func (m *DeleteAdminRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAdminRequest.Size(m)
+}
+func (m *DeleteAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAdminRequest proto.InternalMessageInfo
+
+func (m *DeleteAdminRequest) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
+	}
+	return ""
+}
+
+type DeleteAdminResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAdminResponse) Reset()         { *m = DeleteAdminResponse{} }
+func (m *DeleteAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAdminResponse) ProtoMessage()    {}
+func (*DeleteAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{63}
+}
+
+func (m *DeleteAdminResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAdminResponse.Unmarshal(m, b)
+}
+func (m *DeleteAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAdminResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAdminResponse.Merge(m, src)
+}
+func (m *DeleteAdminResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAdminResponse.Size(m)
+}
+func (m *DeleteAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAdminResponse proto.InternalMessageInfo
+
+func (m *DeleteAdminResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type DeleteSponsorRequest struct {
+	SponsorId            string   `protobuf:"bytes,1,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSponsorRequest) Reset()         { *m = DeleteSponsorRequest{} }
+func (m *DeleteSponsorRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSponsorRequest) ProtoMessage()    {}
+func (*DeleteSponsorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{64}
+}
+
+func (m *DeleteSponsorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSponsorRequest.Unmarshal(m, b)
+}
+func (m *DeleteSponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSponsorRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteSponsorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSponsorRequest.Merge(m, src)
+}
+func (m *DeleteSponsorRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteSponsorRequest.Size(m)
+}
+func (m *DeleteSponsorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSponsorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSponsorRequest proto.InternalMessageInfo
+
+func (m *DeleteSponsorRequest) GetSponsorId() string {
+	if m != nil {
+		return m.SponsorId
+	}
+	return ""
+}
+
+type DeleteSponsorResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSponsorResponse) Reset()         { *m = DeleteSponsorResponse{} }
+func (m *DeleteSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteSponsorResponse) ProtoMessage()    {}
+func (*DeleteSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{65}
+}
+
+func (m *DeleteSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSponsorResponse.Unmarshal(m, b)
+}
+func (m *DeleteSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSponsorResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSponsorResponse.Merge(m, src)
+}
+func (m *DeleteSponsorResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteSponsorResponse.Size(m)
+}
+func (m *DeleteSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSponsorResponse proto.InternalMessageInfo
+
+func (m *DeleteSponsorResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type DeleteCompanyRequest struct {
+	CompanyId            string   `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCompanyRequest) Reset()         { *m = DeleteCompanyRequest{} }
+func (m *DeleteCompanyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCompanyRequest) ProtoMessage()    {}
+func (*DeleteCompanyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{66}
+}
+
+func (m *DeleteCompanyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCompanyRequest.Unmarshal(m, b)
+}
+func (m *DeleteCompanyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCompanyRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCompanyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCompanyRequest.Merge(m, src)
+}
+func (m *DeleteCompanyRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCompanyRequest.Size(m)
+}
+func (m *DeleteCompanyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCompanyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCompanyRequest proto.InternalMessageInfo
+
+func (m *DeleteCompanyRequest) GetCompanyId() string {
+	if m != nil {
+		return m.CompanyId
+	}
+	return ""
+}
+
+type DeleteCompanyResponse struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCompanyResponse) Reset()         { *m = DeleteCompanyResponse{} }
+func (m *DeleteCompanyResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteCompanyResponse) ProtoMessage()    {}
+func (*DeleteCompanyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{67}
+}
+
+func (m *DeleteCompanyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCompanyResponse.Unmarshal(m, b)
+}
+func (m *DeleteCompanyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCompanyResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteCompanyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCompanyResponse.Merge(m, src)
+}
+func (m *DeleteCompanyResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteCompanyResponse.Size(m)
+}
+func (m *DeleteCompanyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCompanyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCompanyResponse proto.InternalMessageInfo
+
+func (m *DeleteCompanyResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+type CreateAdminRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	PasswordPlainText    string   `protobuf:"bytes,3,opt,name=password_plain_text,json=passwordPlainText,proto3" json:"password_plain_text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAdminRequest) Reset()         { *m = CreateAdminRequest{} }
+func (m *CreateAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAdminRequest) ProtoMessage()    {}
+func (*CreateAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{68}
+}
+
+func (m *CreateAdminRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAdminRequest.Unmarshal(m, b)
+}
+func (m *CreateAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAdminRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAdminRequest.Merge(m, src)
+}
+func (m *CreateAdminRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAdminRequest.Size(m)
+}
+func (m *CreateAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAdminRequest proto.InternalMessageInfo
+
+func (m *CreateAdminRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAdminRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *CreateAdminRequest) GetPasswordPlainText() string {
+	if m != nil {
+		return m.PasswordPlainText
+	}
+	return ""
+}
+
+type CreateAdminResponse struct {
+	Admin                *Admin   `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAdminResponse) Reset()         { *m = CreateAdminResponse{} }
+func (m *CreateAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAdminResponse) ProtoMessage()    {}
+func (*CreateAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{69}
+}
+
+func (m *CreateAdminResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAdminResponse.Unmarshal(m, b)
+}
+func (m *CreateAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAdminResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAdminResponse.Merge(m, src)
+}
+func (m *CreateAdminResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAdminResponse.Size(m)
+}
+func (m *CreateAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAdminResponse proto.InternalMessageInfo
+
+func (m *CreateAdminResponse) GetAdmin() *Admin {
+	if m != nil {
+		return m.Admin
+	}
+	return nil
+}
+
+type GetAdminRequest struct {
+	AdminId              string   `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAdminRequest) Reset()         { *m = GetAdminRequest{} }
+func (m *GetAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAdminRequest) ProtoMessage()    {}
+func (*GetAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{70}
+}
+
+func (m *GetAdminRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAdminRequest.Unmarshal(m, b)
+}
+func (m *GetAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAdminRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAdminRequest.Merge(m, src)
+}
+func (m *GetAdminRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAdminRequest.Size(m)
+}
+func (m *GetAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAdminRequest proto.InternalMessageInfo
+
+func (m *GetAdminRequest) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
+	}
+	return ""
+}
+
+type GetAdminResponse struct {
+	Admin                *Admin   `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAdminResponse) Reset()         { *m = GetAdminResponse{} }
+func (m *GetAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAdminResponse) ProtoMessage()    {}
+func (*GetAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{71}
+}
+
+func (m *GetAdminResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAdminResponse.Unmarshal(m, b)
+}
+func (m *GetAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAdminResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAdminResponse.Merge(m, src)
+}
+func (m *GetAdminResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAdminResponse.Size(m)
+}
+func (m *GetAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAdminResponse proto.InternalMessageInfo
+
+func (m *GetAdminResponse) GetAdmin() *Admin {
+	if m != nil {
+		return m.Admin
+	}
+	return nil
+}
+
+type Admin struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email                string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Password             string   `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	ACL                  string   `protobuf:"bytes,5,opt,name=ACL,proto3" json:"ACL,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Admin) Reset()         { *m = Admin{} }
+func (m *Admin) String() string { return proto.CompactTextString(m) }
+func (*Admin) ProtoMessage()    {}
+func (*Admin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{72}
+}
+
+func (m *Admin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Admin.Unmarshal(m, b)
+}
+func (m *Admin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Admin.Marshal(b, m, deterministic)
+}
+func (m *Admin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Admin.Merge(m, src)
+}
+func (m *Admin) XXX_Size() int {
+	return xxx_messageInfo_Admin.Size(m)
+}
+func (m *Admin) XXX_DiscardUnknown() {
+	xxx_messageInfo_Admin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Admin proto.InternalMessageInfo
+
+func (m *Admin) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Admin) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Admin) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Admin) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *Admin) GetACL() string {
+	if m != nil {
+		return m.ACL
+	}
+	return ""
+}
+
+type Sponsor struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email                string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Password             string   `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Company              *Company `protobuf:"bytes,5,opt,name=company,proto3" json:"company,omitempty"`
+	ACL                  string   `protobuf:"bytes,6,opt,name=ACL,proto3" json:"ACL,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Sponsor) Reset()         { *m = Sponsor{} }
+func (m *Sponsor) String() string { return proto.CompactTextString(m) }
+func (*Sponsor) ProtoMessage()    {}
+func (*Sponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{73}
+}
+
+func (m *Sponsor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Sponsor.Unmarshal(m, b)
+}
+func (m *Sponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Sponsor.Marshal(b, m, deterministic)
+}
+func (m *Sponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsor.Merge(m, src)
+}
+func (m *Sponsor) XXX_Size() int {
+	return xxx_messageInfo_Sponsor.Size(m)
+}
+func (m *Sponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsor proto.InternalMessageInfo
+
+func (m *Sponsor) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Sponsor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Sponsor) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Sponsor) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *Sponsor) GetCompany() *Company {
+	if m != nil {
+		return m.Company
+	}
+	return nil
+}
+
+func (m *Sponsor) GetACL() string {
+	if m != nil {
+		return m.ACL
+	}
+	return ""
+}
+
+type Company struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Logo string `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
+	// tier is the sponsorship tier of the company e.g. gold
+	Tier                 string   `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Company) Reset()         { *m = Company{} }
+func (m *Company) String() string { return proto.CompactTextString(m) }
+func (*Company) ProtoMessage()    {}
+func (*Company) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{74}
+}
+
+func (m *Company) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Company.Unmarshal(m, b)
+}
+func (m *Company) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Company.Marshal(b, m, deterministic)
+}
+func (m *Company) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Company.Merge(m, src)
+}
+func (m *Company) XXX_Size() int {
+	return xxx_messageInfo_Company.Size(m)
+}
+func (m *Company) XXX_DiscardUnknown() {
+	xxx_messageInfo_Company.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Company proto.InternalMessageInfo
+
+func (m *Company) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Company) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Company) GetLogo() string {
+	if m != nil {
+		return m.Logo
+	}
+	return ""
+}
+
+func (m *Company) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
+}
+
+type Participant struct {
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Github   string `protobuf:"bytes,3,opt,name=github,proto3" json:"github,omitempty"`
+	Linkedin string `protobuf:"bytes,4,opt,name=linkedin,proto3" json:"linkedin,omitempty"`
+	// resume is always empty since the url of a resume is a permanent
+	// public link, use has_resume and GetResume instead
+	Resume     string `protobuf:"bytes,5,opt,name=resume,proto3" json:"resume,omitempty"`
+	Email      string `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	University string `protobuf:"bytes,7,opt,name=university,proto3" json:"university,omitempty"`
+	Major      string `protobuf:"bytes,8,opt,name=major,proto3" json:"major,omitempty"`
+	GradYear   int32  `protobuf:"varint,9,opt,name=grad_year,json=gradYear,proto3" json:"grad_year,omitempty"`
+	// has_resume is true when the participant uploaded a resume, it can be
+	// viewed through GetResume
+	HasResume            bool     `protobuf:"varint,10,opt,name=has_resume,json=hasResume,proto3" json:"has_resume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Participant) Reset()         { *m = Participant{} }
+func (m *Participant) String() string { return proto.CompactTextString(m) }
+func (*Participant) ProtoMessage()    {}
+func (*Participant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{75}
+}
+
+func (m *Participant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Participant.Unmarshal(m, b)
+}
+func (m *Participant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Participant.Marshal(b, m, deterministic)
+}
+func (m *Participant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Participant.Merge(m, src)
+}
+func (m *Participant) XXX_Size() int {
+	return xxx_messageInfo_Participant.Size(m)
+}
+func (m *Participant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Participant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Participant proto.InternalMessageInfo
+
+func (m *Participant) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Participant) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Participant) GetGithub() string {
+	if m != nil {
+		return m.Github
+	}
+	return ""
+}
+
+func (m *Participant) GetLinkedin() string {
+	if m != nil {
+		return m.Linkedin
+	}
+	return ""
+}
+
+func (m *Participant) GetResume() string {
+	if m != nil {
+		return m.Resume
+	}
+	return ""
+}
+
+func (m *Participant) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Participant) GetUniversity() string {
+	if m != nil {
+		return m.University
+	}
+	return ""
+}
+
+func (m *Participant) GetMajor() string {
+	if m != nil {
+		return m.Major
+	}
+	return ""
+}
+
+func (m *Participant) GetGradYear() int32 {
+	if m != nil {
+		return m.GradYear
+	}
+	return 0
+}
+
+func (m *Participant) GetHasResume() bool {
+	if m != nil {
+		return m.HasResume
+	}
+	return false
+}
+
+type Note struct {
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParticipantId string `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	SponsorId     string `protobuf:"bytes,3,opt,name=sponsor_id,json=sponsorId,proto3" json:"sponsor_id,omitempty"`
+	Body          string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// rating is left out when the participant was not rated
+	Rating *wrappers.Int32Value `protobuf:"bytes,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	// created_at and updated_at are unix timestamps in seconds
+	CreatedAt            int64    `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            int64    `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Note) Reset()         { *m = Note{} }
+func (m *Note) String() string { return proto.CompactTextString(m) }
+func (*Note) ProtoMessage()    {}
+func (*Note) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{76}
+}
+
+func (m *Note) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Note.Unmarshal(m, b)
+}
+func (m *Note) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Note.Marshal(b, m, deterministic)
+}
+func (m *Note) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Note.Merge(m, src)
+}
+func (m *Note) XXX_Size() int {
+	return xxx_messageInfo_Note.Size(m)
+}
+func (m *Note) XXX_DiscardUnknown() {
+	xxx_messageInfo_Note.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Note proto.InternalMessageInfo
+
+func (m *Note) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Note) GetParticipantId() string {
+	if m != nil {
+		return m.ParticipantId
+	}
+	return ""
+}
+
+func (m *Note) GetSponsorId() string {
+	if m != nil {
+		return m.SponsorId
+	}
+	return ""
+}
+
+func (m *Note) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *Note) GetRating() *wrappers.Int32Value {
+	if m != nil {
+		return m.Rating
+	}
+	return nil
+}
+
+func (m *Note) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Note) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type SyncStatus struct {
+	Source  string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Running bool   `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	Full    bool   `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
+	// last_start and last_finish are unix timestamps in seconds
+	LastStart  int64 `protobuf:"varint,4,opt,name=last_start,json=lastStart,proto3" json:"last_start,omitempty"`
+	LastFinish int64 `protobuf:"varint,5,opt,name=last_finish,json=lastFinish,proto3" json:"last_finish,omitempty"`
+	DurationMs int64 `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Saved      int32 `protobuf:"varint,7,opt,name=saved,proto3" json:"saved,omitempty"`
+	Deleted    int32 `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// failures has an error message for every participant and every
+	// resume that could not be synced
+	Failures  []string `protobuf:"bytes,9,rep,name=failures,proto3" json:"failures,omitempty"`
+	LastError string   `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// mirrored is the number of resumes mirrored after the participants
+	// were saved
+	Mirrored             int32    `protobuf:"varint,11,opt,name=mirrored,proto3" json:"mirrored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
+func (m *SyncStatus) String() string { return proto.CompactTextString(m) }
+func (*SyncStatus) ProtoMessage()    {}
+func (*SyncStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b93c2e50814def43, []int{77}
+}
+
+func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncStatus.Unmarshal(m, b)
+}
+func (m *SyncStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncStatus.Marshal(b, m, deterministic)
+}
+func (m *SyncStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatus.Merge(m, src)
+}
+func (m *SyncStatus) XXX_Size() int {
+	return xxx_messageInfo_SyncStatus.Size(m)
+}
+func (m *SyncStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
+
+func (m *SyncStatus) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+func (m *SyncStatus) GetFull() bool {
+	if m != nil {
+		return m.Full
+	}
+	return false
+}
+
+func (m *SyncStatus) GetLastStart() int64 {
+	if m != nil {
+		return m.LastStart
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetLastFinish() int64 {
+	if m != nil {
+		return m.LastFinish
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetSaved() int32 {
+	if m != nil {
+		return m.Saved
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetDeleted() int32 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetFailures() []string {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *SyncStatus) GetMirrored() int32 {
+	if m != nil {
+		return m.Mirrored
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GetSyncStatusRequest)(nil), "proto.GetSyncStatusRequest")
+	proto.RegisterType((*GetSyncStatusResponse)(nil), "proto.GetSyncStatusResponse")
+	proto.RegisterType((*TriggerSyncRequest)(nil), "proto.TriggerSyncRequest")
+	proto.RegisterType((*TriggerSyncResponse)(nil), "proto.TriggerSyncResponse")
+	proto.RegisterType((*AddToShortlistRequest)(nil), "proto.AddToShortlistRequest")
+	proto.RegisterType((*AddToShortlistResponse)(nil), "proto.AddToShortlistResponse")
+	proto.RegisterType((*RemoveFromShortlistRequest)(nil), "proto.RemoveFromShortlistRequest")
+	proto.RegisterType((*RemoveFromShortlistResponse)(nil), "proto.RemoveFromShortlistResponse")
+	proto.RegisterType((*ListShortlistRequest)(nil), "proto.ListShortlistRequest")
+	proto.RegisterType((*ListShortlistResponse)(nil), "proto.ListShortlistResponse")
+	proto.RegisterType((*CreateNoteRequest)(nil), "proto.CreateNoteRequest")
+	proto.RegisterType((*CreateNoteResponse)(nil), "proto.CreateNoteResponse")
+	proto.RegisterType((*ListNotesRequest)(nil), "proto.ListNotesRequest")
+	proto.RegisterType((*ListNotesResponse)(nil), "proto.ListNotesResponse")
+	proto.RegisterType((*UpdateNoteRequest)(nil), "proto.UpdateNoteRequest")
+	proto.RegisterType((*UpdateNoteResponse)(nil), "proto.UpdateNoteResponse")
+	proto.RegisterType((*DeleteNoteRequest)(nil), "proto.DeleteNoteRequest")
+	proto.RegisterType((*DeleteNoteResponse)(nil), "proto.DeleteNoteResponse")
+	proto.RegisterType((*GetSponsorRequest)(nil), "proto.GetSponsorRequest")
+	proto.RegisterType((*GetSponsorResponse)(nil), "proto.GetSponsorResponse")
+	proto.RegisterType((*ResumesRequest)(nil), "proto.ResumesRequest")
+	proto.RegisterType((*ResumesResponse)(nil), "proto.ResumesResponse")
+	proto.RegisterType((*ResumesChunk)(nil), "proto.ResumesChunk")
+	proto.RegisterType((*GetResumeRequest)(nil), "proto.GetResumeRequest")
+	proto.RegisterType((*GetResumeResponse)(nil), "proto.GetResumeResponse")
+	proto.RegisterType((*ListCompaniesResponse)(nil), "proto.ListCompaniesResponse")
+	proto.RegisterType((*ListCompaniesRequest)(nil), "proto.ListCompaniesRequest")
+	proto.RegisterType((*LoginSponsorRequest)(nil), "proto.LoginSponsorRequest")
+	proto.RegisterType((*LoginSponsorResponse)(nil), "proto.LoginSponsorResponse")
+	proto.RegisterType((*RefreshTokenRequest)(nil), "proto.RefreshTokenRequest")
+	proto.RegisterType((*RefreshTokenResponse)(nil), "proto.RefreshTokenResponse")
+	proto.RegisterType((*LogoutRequest)(nil), "proto.LogoutRequest")
+	proto.RegisterType((*LogoutResponse)(nil), "proto.LogoutResponse")
+	proto.RegisterType((*ListParticipantsRequest)(nil), "proto.ListParticipantsRequest")
+	proto.RegisterType((*ListParticipantsResponse)(nil), "proto.ListParticipantsResponse")
+	proto.RegisterType((*SearchParticipantsRequest)(nil), "proto.SearchParticipantsRequest")
+	proto.RegisterType((*SearchParticipantsResponse)(nil), "proto.SearchParticipantsResponse")
+	proto.RegisterType((*SearchResult)(nil), "proto.SearchResult")
+	proto.RegisterType((*UpdateSponsorRequest)(nil), "proto.UpdateSponsorRequest")
+	proto.RegisterType((*UpdateSponsorResponse)(nil), "proto.UpdateSponsorResponse")
+	proto.RegisterType((*UpdateAdminRequest)(nil), "proto.UpdateAdminRequest")
+	proto.RegisterType((*UpdateAdminResponse)(nil), "proto.UpdateAdminResponse")
+	proto.RegisterType((*CreateSponsorRequest)(nil), "proto.CreateSponsorRequest")
+	proto.RegisterType((*CreateSponsorResponse)(nil), "proto.CreateSponsorResponse")
+	proto.RegisterType((*AcceptInviteRequest)(nil), "proto.AcceptInviteRequest")
+	proto.RegisterType((*AcceptInviteResponse)(nil), "proto.AcceptInviteResponse")
+	proto.RegisterType((*RequestPasswordResetRequest)(nil), "proto.RequestPasswordResetRequest")
+	proto.RegisterType((*RequestPasswordResetResponse)(nil), "proto.RequestPasswordResetResponse")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "proto.ResetPasswordRequest")
+	proto.RegisterType((*ResetPasswordResponse)(nil), "proto.ResetPasswordResponse")
+	proto.RegisterType((*CreateCompanyRequest)(nil), "proto.CreateCompanyRequest")
+	proto.RegisterType((*CreateCompanyResponse)(nil), "proto.CreateCompanyResponse")
+	proto.RegisterType((*GetCompanyRequest)(nil), "proto.GetCompanyRequest")
+	proto.RegisterType((*GetCompanyResponse)(nil), "proto.GetCompanyResponse")
+	proto.RegisterType((*UpdateCompanyRequest)(nil), "proto.UpdateCompanyRequest")
+	proto.RegisterType((*UpdateCompanyResponse)(nil), "proto.UpdateCompanyResponse")
+	proto.RegisterType((*SetCompanyTierRequest)(nil), "proto.SetCompanyTierRequest")
+	proto.RegisterType((*SetCompanyTierResponse)(nil), "proto.SetCompanyTierResponse")
+	proto.RegisterType((*ListCompanySponsorsRequest)(nil), "proto.ListCompanySponsorsRequest")
+	proto.RegisterType((*ListCompanySponsorsResponse)(nil), "proto.ListCompanySponsorsResponse")
+	proto.RegisterType((*LoginAdminRequest)(nil), "proto.LoginAdminRequest")
+	proto.RegisterType((*LoginAdminResponse)(nil), "proto.LoginAdminResponse")
+	proto.RegisterType((*DeleteAdminRequest)(nil), "proto.DeleteAdminRequest")
+	proto.RegisterType((*DeleteAdminResponse)(nil), "proto.DeleteAdminResponse")
+	proto.RegisterType((*DeleteSponsorRequest)(nil), "proto.DeleteSponsorRequest")
+	proto.RegisterType((*DeleteSponsorResponse)(nil), "proto.DeleteSponsorResponse")
+	proto.RegisterType((*DeleteCompanyRequest)(nil), "proto.DeleteCompanyRequest")
+	proto.RegisterType((*DeleteCompanyResponse)(nil), "proto.DeleteCompanyResponse")
+	proto.RegisterType((*CreateAdminRequest)(nil), "proto.CreateAdminRequest")
+	proto.RegisterType((*CreateAdminResponse)(nil), "proto.CreateAdminResponse")
+	proto.RegisterType((*GetAdminRequest)(nil), "proto.GetAdminRequest")
+	proto.RegisterType((*GetAdminResponse)(nil), "proto.GetAdminResponse")
+	proto.RegisterType((*Admin)(nil), "proto.Admin")
+	proto.RegisterType((*Sponsor)(nil), "proto.Sponsor")
+	proto.RegisterType((*Company)(nil), "proto.Company")
+	proto.RegisterType((*Participant)(nil), "proto.Participant")
+	proto.RegisterType((*Note)(nil), "proto.Note")
+	proto.RegisterType((*SyncStatus)(nil), "proto.SyncStatus")
+}
+
+func init() { proto.RegisterFile("sponsor.proto", fileDescriptor_b93c2e50814def43) }
+
+var fileDescriptor_b93c2e50814def43 = []byte{
+	// 2891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xd1, 0x6f, 0x1b, 0xc7,
+	0xd1, 0xff, 0x48, 0x8a, 0xa2, 0x38, 0x14, 0x65, 0x6b, 0x45, 0xc9, 0xd4, 0x8a, 0xb2, 0xe4, 0x4d,
+	0x6c, 0x2b, 0x4a, 0x22, 0x26, 0x76, 0x92, 0xef, 0x4b, 0x1e, 0xf2, 0x7d, 0x8a, 0xbf, 0xc4, 0x75,
+	0xe3, 0x14, 0xee, 0x49, 0x29, 0x50, 0x14, 0x28, 0x71, 0x12, 0x57, 0xd2, 0x45, 0xe4, 0x1d, 0x7d,
+	0x77, 0x94, 0xa5, 0x1a, 0x46, 0x81, 0x14, 0x7d, 0x2b, 0x5a, 0xa0, 0x7d, 0x6b, 0xd1, 0x7f, 0xa4,
+	0x8f, 0x7d, 0xee, 0x5b, 0xff, 0x84, 0x16, 0xe8, 0xbf, 0x51, 0xec, 0xde, 0xec, 0xdd, 0xde, 0xdd,
+	0x1e, 0x45, 0xba, 0x69, 0x9f, 0xc8, 0xdd, 0x99, 0x9b, 0xdf, 0xec, 0xcc, 0xec, 0xec, 0xce, 0xee,
+	0x42, 0x33, 0x18, 0x79, 0x6e, 0xe0, 0xf9, 0x7b, 0x23, 0xdf, 0x0b, 0x3d, 0x52, 0x95, 0x3f, 0xb4,
+	0x73, 0xea, 0x79, 0xa7, 0x03, 0xde, 0xb5, 0x47, 0x4e, 0xd7, 0x76, 0x5d, 0x2f, 0xb4, 0x43, 0xc7,
+	0x73, 0x83, 0x88, 0x89, 0xde, 0x46, 0xaa, 0x6c, 0x1d, 0x8d, 0x4f, 0xba, 0x2f, 0x7c, 0x7b, 0x34,
+	0xe2, 0x3e, 0xd2, 0xd9, 0x1a, 0xb4, 0x1e, 0xf3, 0xf0, 0xe0, 0xca, 0x3d, 0x3e, 0x08, 0xed, 0x70,
+	0x1c, 0x58, 0xfc, 0xf9, 0x98, 0x07, 0x21, 0xfb, 0x0c, 0x56, 0x33, 0xfd, 0x12, 0x9c, 0x93, 0xb7,
+	0x60, 0x3e, 0x90, 0x3d, 0xed, 0xd2, 0x76, 0x69, 0xa7, 0xf1, 0x60, 0x39, 0x12, 0xb4, 0xa7, 0xb1,
+	0x22, 0x03, 0xdb, 0x01, 0x72, 0xe8, 0x3b, 0xa7, 0xa7, 0xdc, 0x17, 0x44, 0x94, 0x4c, 0x08, 0xcc,
+	0x9d, 0x8c, 0x07, 0x03, 0xf9, 0xf9, 0x82, 0x25, 0xff, 0xb3, 0xbb, 0xb0, 0x92, 0xe2, 0x44, 0xac,
+	0x25, 0x28, 0x7b, 0xe7, 0xc8, 0x58, 0xf6, 0xce, 0xd9, 0xa7, 0xb0, 0xba, 0xdf, 0xef, 0x1f, 0x7a,
+	0x07, 0x67, 0x9e, 0x1f, 0x0e, 0x9c, 0x20, 0x54, 0x32, 0xef, 0xc2, 0xd2, 0xc8, 0xf6, 0x43, 0xe7,
+	0xd8, 0x19, 0xd9, 0x6e, 0xd8, 0x73, 0xfa, 0xf2, 0xa3, 0xba, 0xd5, 0xd4, 0x7a, 0x9f, 0xf4, 0xd9,
+	0x0e, 0xac, 0x65, 0xbf, 0x2f, 0x40, 0x7a, 0x04, 0xd4, 0xe2, 0x43, 0xef, 0x82, 0x7f, 0xe1, 0x7b,
+	0xc3, 0xd7, 0x85, 0x7b, 0x17, 0x36, 0x8c, 0x42, 0x0a, 0x30, 0xbf, 0x84, 0xd6, 0x53, 0x27, 0x08,
+	0x73, 0x68, 0x2d, 0xa8, 0x0e, 0x9c, 0xa1, 0x13, 0x4a, 0xd6, 0xaa, 0x15, 0x35, 0xc8, 0x26, 0xc0,
+	0xc8, 0x3e, 0xe5, 0xbd, 0xd0, 0x3b, 0xe7, 0x6e, 0xbb, 0x2c, 0xf1, 0xeb, 0xa2, 0xe7, 0x50, 0x74,
+	0xb0, 0x17, 0xb0, 0x9a, 0x11, 0x86, 0xa8, 0x1f, 0xc1, 0xa2, 0xa6, 0xa5, 0xf0, 0x62, 0x65, 0xa7,
+	0xf1, 0x80, 0xa0, 0x17, 0x9f, 0x25, 0x24, 0x2b, 0xc5, 0x47, 0xee, 0xc1, 0x0d, 0x97, 0x5f, 0x86,
+	0xbd, 0x1c, 0x68, 0x53, 0x74, 0x3f, 0x8b, 0x81, 0x7f, 0x51, 0x82, 0xe5, 0x47, 0x3e, 0xb7, 0x43,
+	0xfe, 0x03, 0x2f, 0xe4, 0xb3, 0x59, 0x4c, 0xc4, 0xc6, 0x91, 0xd7, 0xbf, 0x42, 0xc9, 0xf2, 0x3f,
+	0x79, 0x08, 0xf3, 0xbe, 0x1d, 0x3a, 0xee, 0x69, 0xbb, 0x22, 0x03, 0x6e, 0x63, 0x2f, 0x0a, 0xe9,
+	0x3d, 0x15, 0xd2, 0x7b, 0x4f, 0xdc, 0xf0, 0xe1, 0x83, 0x1f, 0xd9, 0x83, 0x31, 0xb7, 0x90, 0x95,
+	0x7d, 0x08, 0x44, 0x57, 0x02, 0xc7, 0xbe, 0x05, 0x73, 0xae, 0x17, 0x72, 0x8c, 0xdc, 0x06, 0x8e,
+	0x59, 0xb2, 0x48, 0x02, 0x73, 0xe1, 0xa6, 0xb0, 0x9a, 0xe8, 0x09, 0x66, 0x54, 0x3d, 0xf6, 0x52,
+	0xb9, 0xd8, 0x4b, 0x95, 0xac, 0x97, 0x7e, 0x0a, 0xcb, 0x1a, 0x1e, 0x6a, 0x79, 0x07, 0xaa, 0x42,
+	0x19, 0xe5, 0x9a, 0x94, 0x9a, 0x11, 0x65, 0x6a, 0x67, 0x8c, 0x61, 0xf9, 0xeb, 0x51, 0x3f, 0xe3,
+	0x8b, 0x5b, 0x50, 0x13, 0x52, 0x92, 0x91, 0xcc, 0x8b, 0xe6, 0x77, 0x6c, 0x7d, 0x1d, 0x76, 0x5a,
+	0xeb, 0xbf, 0x03, 0xcb, 0xff, 0xcf, 0x07, 0x7c, 0x3a, 0x6d, 0xd9, 0x9b, 0x40, 0x74, 0xee, 0x82,
+	0x49, 0xf5, 0x00, 0x96, 0x45, 0x1e, 0x8b, 0x12, 0xa7, 0x92, 0xb9, 0x09, 0x80, 0xa9, 0x34, 0x11,
+	0x5b, 0xc7, 0x9e, 0x27, 0x7d, 0xf6, 0x29, 0x10, 0xfd, 0x1b, 0x94, 0xbc, 0x03, 0x35, 0x64, 0xc1,
+	0x11, 0x2c, 0xa9, 0xcc, 0x87, 0x8c, 0x8a, 0xcc, 0xfe, 0x58, 0x86, 0x25, 0x8b, 0x07, 0xe3, 0x61,
+	0x12, 0x44, 0xe6, 0x39, 0xbc, 0x06, 0xf3, 0x27, 0x9e, 0x3f, 0xb4, 0x43, 0x34, 0x39, 0xb6, 0xc8,
+	0x7d, 0xb8, 0x91, 0x0e, 0xb9, 0xa0, 0x5d, 0xd9, 0xae, 0xec, 0xd4, 0xad, 0xa5, 0x54, 0xcc, 0x05,
+	0xe4, 0x36, 0xc0, 0xd8, 0x75, 0x2e, 0xb8, 0x1f, 0x38, 0xe1, 0x55, 0x7b, 0x4e, 0x0a, 0xd1, 0x7a,
+	0x04, 0xec, 0xd0, 0xfe, 0xc6, 0xf3, 0xdb, 0x55, 0x49, 0x8a, 0x1a, 0x84, 0x41, 0xf3, 0xd4, 0xb7,
+	0xfb, 0xbd, 0x2b, 0x6e, 0xfb, 0xbd, 0xa1, 0xe3, 0xb6, 0xe7, 0xa5, 0x52, 0x0d, 0xd1, 0xf9, 0x63,
+	0x6e, 0xfb, 0x5f, 0x39, 0x6e, 0x86, 0xc7, 0xbe, 0x6c, 0xd7, 0x32, 0x3c, 0xf6, 0xa5, 0x90, 0xfe,
+	0x7c, 0xcc, 0xfd, 0xab, 0xf6, 0x42, 0x24, 0x5d, 0x36, 0xc8, 0x36, 0x34, 0x02, 0x95, 0x75, 0x78,
+	0xbf, 0x5d, 0x97, 0xae, 0xd0, 0xbb, 0xd8, 0xdb, 0x70, 0x23, 0x36, 0x0f, 0x1a, 0xb7, 0x0d, 0x35,
+	0xdb, 0x3f, 0x3e, 0x73, 0x2e, 0xa2, 0xf0, 0x58, 0xb4, 0x54, 0x93, 0x31, 0x58, 0x44, 0xe6, 0x47,
+	0x67, 0x63, 0xf7, 0x5c, 0x04, 0x69, 0xdf, 0x0e, 0x6d, 0x64, 0x93, 0xff, 0xd9, 0x0f, 0xe1, 0xe6,
+	0x63, 0x1e, 0x46, 0x6c, 0x33, 0x4e, 0xdb, 0x35, 0x98, 0x77, 0xdc, 0x81, 0xe3, 0x72, 0xe9, 0x82,
+	0x05, 0x0b, 0x5b, 0xec, 0x25, 0x2c, 0x6b, 0x22, 0x51, 0x4b, 0x03, 0x36, 0xb9, 0x03, 0x8b, 0xc7,
+	0x9e, 0x1b, 0x72, 0x37, 0xec, 0x85, 0x57, 0x23, 0x8e, 0x9e, 0x6c, 0x60, 0xdf, 0xe1, 0xd5, 0x88,
+	0x93, 0x9b, 0x50, 0x19, 0xfb, 0x03, 0x9c, 0xfd, 0xe2, 0xaf, 0x08, 0x40, 0x7e, 0x39, 0x72, 0x7c,
+	0x1e, 0xf4, 0xec, 0x50, 0xfa, 0xad, 0x62, 0xd5, 0xb1, 0x67, 0x3f, 0x64, 0xc3, 0x28, 0x79, 0x3f,
+	0xf2, 0x86, 0x23, 0xdb, 0x75, 0x34, 0x33, 0xbd, 0x03, 0xf5, 0x63, 0xd5, 0x89, 0xe9, 0x41, 0x45,
+	0x61, 0xc4, 0x7c, 0x65, 0x25, 0x0c, 0x53, 0x67, 0x09, 0x5c, 0x78, 0x34, 0xb8, 0x7f, 0x61, 0xe1,
+	0xf9, 0x09, 0xac, 0x3c, 0xf5, 0x4e, 0x1d, 0x37, 0x33, 0xe5, 0x5a, 0x50, 0xe5, 0x43, 0xdb, 0x19,
+	0xa0, 0x17, 0xa2, 0x06, 0xd9, 0x83, 0x95, 0x91, 0x1d, 0x04, 0x2f, 0x3c, 0xbf, 0xdf, 0x1b, 0x0d,
+	0x6c, 0xc7, 0xed, 0x85, 0xfc, 0x52, 0xcd, 0x86, 0x65, 0x45, 0x7a, 0x26, 0x28, 0x87, 0xfc, 0x32,
+	0x64, 0xbf, 0x2f, 0x41, 0x2b, 0x2d, 0x1d, 0x0d, 0xd3, 0x82, 0x6a, 0xa4, 0x0f, 0x8a, 0x97, 0x0d,
+	0x7d, 0xca, 0x96, 0x27, 0x4e, 0x59, 0xf2, 0x06, 0x34, 0x7d, 0x7e, 0xe2, 0xf3, 0xe0, 0x2c, 0x95,
+	0xaa, 0x17, 0xb1, 0x53, 0x0e, 0xed, 0x3a, 0xaf, 0x7d, 0x02, 0x2b, 0x96, 0xc6, 0xae, 0x46, 0x9e,
+	0x13, 0x5d, 0xca, 0x8b, 0x66, 0x23, 0x68, 0xa5, 0xbf, 0x9d, 0x38, 0xae, 0x9c, 0xc8, 0xf2, 0xb5,
+	0xda, 0x56, 0xb2, 0xda, 0x1e, 0x42, 0xf3, 0xa9, 0x77, 0xea, 0x8d, 0xc3, 0x59, 0xf4, 0x14, 0x09,
+	0x87, 0x5f, 0x70, 0xff, 0xea, 0xc5, 0x19, 0xf7, 0xd5, 0x94, 0xd1, 0x7a, 0xd8, 0x36, 0x2c, 0x29,
+	0xa9, 0x05, 0x09, 0xf9, 0xcf, 0x65, 0xb8, 0x25, 0xa2, 0x4d, 0xdb, 0x69, 0x5c, 0x13, 0x70, 0xe9,
+	0x24, 0x57, 0x2e, 0x4e, 0x72, 0x95, 0x89, 0x49, 0x6e, 0x6e, 0x8a, 0x24, 0x57, 0xcd, 0x27, 0xb9,
+	0x4d, 0x80, 0x33, 0x3b, 0xe8, 0xf9, 0x32, 0x13, 0xc8, 0x4c, 0xb9, 0x60, 0xd5, 0xcf, 0xec, 0x20,
+	0x4a, 0x0d, 0x8a, 0x7c, 0xea, 0x84, 0x67, 0xe3, 0xa3, 0x76, 0x2d, 0x26, 0x3f, 0x96, 0x1d, 0x05,
+	0x29, 0x32, 0x3d, 0x85, 0xea, 0x99, 0x29, 0x94, 0xcd, 0xa0, 0x90, 0xcf, 0xa0, 0x3f, 0x83, 0x76,
+	0xde, 0x86, 0xff, 0xa1, 0x0d, 0xde, 0x3f, 0x4a, 0xb0, 0x7e, 0xc0, 0x45, 0x7a, 0x2e, 0x70, 0x61,
+	0x34, 0xe0, 0x92, 0x3e, 0x60, 0xf3, 0xe6, 0x28, 0xed, 0xd8, 0x4a, 0xb1, 0x63, 0xe7, 0x26, 0x3a,
+	0xb6, 0x3a, 0x85, 0x63, 0xe7, 0xf3, 0x8e, 0xcd, 0x58, 0xb9, 0x96, 0xb7, 0xf2, 0x97, 0x40, 0x4d,
+	0x03, 0x45, 0x3b, 0xbf, 0x0b, 0x35, 0x11, 0x14, 0x83, 0xd8, 0xc4, 0x2b, 0x2a, 0xb9, 0xc8, 0x6f,
+	0x2c, 0x49, 0xb3, 0x14, 0x0f, 0xf3, 0x61, 0x51, 0x27, 0x90, 0x0f, 0xa0, 0xa1, 0x99, 0x1f, 0xb7,
+	0x14, 0x26, 0x2f, 0xe9, 0x6c, 0x62, 0x05, 0xf2, 0x6d, 0xf7, 0x5c, 0xda, 0xb1, 0x6c, 0xc9, 0xff,
+	0x62, 0xed, 0x0c, 0x5c, 0x67, 0x34, 0xe2, 0x21, 0xda, 0x50, 0x35, 0x59, 0x0f, 0x5a, 0xd1, 0x3e,
+	0x6c, 0xa6, 0xfd, 0xcf, 0xf4, 0x69, 0x93, 0xed, 0xc3, 0x6a, 0x06, 0x60, 0xe6, 0xcd, 0xd2, 0x81,
+	0xda, 0x2b, 0xee, 0xf7, 0x87, 0x4e, 0x9c, 0x34, 0xd7, 0x61, 0xc1, 0x16, 0xed, 0x44, 0xbf, 0x9a,
+	0x6c, 0x3f, 0xe9, 0x13, 0x06, 0x55, 0xf9, 0x17, 0x75, 0x5b, 0x44, 0xc1, 0xd1, 0xe7, 0x11, 0x89,
+	0x7d, 0x0c, 0x2b, 0x29, 0xa1, 0xa8, 0x55, 0xfc, 0x69, 0xa9, 0xf8, 0xd3, 0xff, 0x83, 0x56, 0x54,
+	0x39, 0x64, 0x6c, 0x36, 0xfd, 0x88, 0x8e, 0x60, 0x35, 0x23, 0x61, 0x56, 0xa3, 0x90, 0x2d, 0x68,
+	0x38, 0xee, 0x85, 0x13, 0xf2, 0x5e, 0xc0, 0xdd, 0x50, 0xe5, 0xd9, 0xa8, 0xeb, 0x80, 0xbb, 0x21,
+	0x7b, 0x0c, 0x2b, 0xfb, 0xc7, 0xc7, 0x7c, 0x14, 0x3e, 0x91, 0x7d, 0xda, 0xec, 0x33, 0x2c, 0x17,
+	0x14, 0x16, 0xd4, 0x52, 0x8a, 0x53, 0x3a, 0x6e, 0xb3, 0x7b, 0xd0, 0x4a, 0x0b, 0x2a, 0x48, 0xdb,
+	0x0f, 0x61, 0x03, 0x41, 0x9e, 0xe1, 0xa7, 0x16, 0x0f, 0x78, 0x38, 0x71, 0x79, 0x67, 0x7b, 0xd0,
+	0x31, 0x7f, 0x54, 0x00, 0xf2, 0x3d, 0xb1, 0x0a, 0x06, 0x5c, 0xe3, 0x7e, 0xdd, 0x61, 0xdd, 0x87,
+	0xd5, 0x8c, 0xa4, 0x02, 0x48, 0x4b, 0xb9, 0x5b, 0xed, 0x9f, 0x92, 0x53, 0x0a, 0xd7, 0x1e, 0x72,
+	0x44, 0x94, 0xff, 0x45, 0xdf, 0xc0, 0x3b, 0xf5, 0x54, 0x7d, 0x24, 0xfe, 0x8b, 0xbe, 0xd0, 0xe1,
+	0x6a, 0xed, 0x91, 0xff, 0xc5, 0xac, 0xc8, 0xc8, 0x4c, 0x02, 0x20, 0xda, 0x9d, 0x5d, 0x65, 0x02,
+	0x40, 0x31, 0x2a, 0x32, 0x96, 0x2d, 0x19, 0x9d, 0x36, 0x01, 0x90, 0xae, 0x4d, 0x5b, 0xec, 0x89,
+	0xcb, 0x96, 0xd7, 0xc7, 0x8c, 0xb3, 0xc5, 0x4c, 0xb0, 0x3a, 0x40, 0x79, 0x32, 0x40, 0x9c, 0x2d,
+	0x5e, 0x5f, 0xc7, 0xef, 0xc3, 0xea, 0x41, 0x3c, 0xc6, 0x43, 0x87, 0xfb, 0x53, 0x2a, 0xa9, 0xdc,
+	0x54, 0xd6, 0xdc, 0xf4, 0x19, 0xac, 0x65, 0x65, 0xcd, 0xac, 0xcf, 0x08, 0x68, 0xb2, 0x75, 0xbe,
+	0xc2, 0x79, 0x1c, 0x4c, 0xa9, 0xd4, 0x6b, 0x1d, 0x19, 0x3c, 0x87, 0x0d, 0x23, 0x22, 0xaa, 0xbe,
+	0x0b, 0x0b, 0x98, 0x44, 0xb2, 0x05, 0x02, 0xb2, 0x5a, 0x31, 0x7d, 0xea, 0x15, 0xff, 0x73, 0x58,
+	0x96, 0x9b, 0xee, 0x54, 0x86, 0x36, 0x6f, 0xe8, 0x27, 0xcd, 0xc9, 0xdf, 0x96, 0x80, 0xe8, 0x72,
+	0x26, 0x6e, 0x71, 0xa7, 0xc8, 0xf2, 0xdf, 0xc9, 0xa6, 0xbd, 0xab, 0x4e, 0x11, 0xa6, 0x5c, 0x7e,
+	0xc4, 0x51, 0x65, 0xea, 0x83, 0x82, 0xbc, 0xf2, 0x21, 0xb4, 0x22, 0xb6, 0xd9, 0x8e, 0x1e, 0xee,
+	0xc3, 0x6a, 0xe6, 0xb3, 0xeb, 0xe4, 0xcf, 0x96, 0x23, 0x62, 0xf9, 0xd9, 0x29, 0x98, 0x95, 0xef,
+	0xaa, 0x03, 0xb4, 0x94, 0x5d, 0x4c, 0x59, 0x31, 0x0e, 0x84, 0xf2, 0x14, 0x95, 0x5d, 0xa5, 0xa8,
+	0xb2, 0xfb, 0x18, 0x56, 0x52, 0x78, 0x33, 0xac, 0xd8, 0xef, 0xc0, 0x8d, 0xc7, 0x3c, 0x9c, 0xd6,
+	0x7f, 0x1f, 0xc1, 0xcd, 0x84, 0x7b, 0x06, 0x14, 0x0f, 0xaa, 0xb2, 0x2d, 0x2c, 0x15, 0x4b, 0x2d,
+	0x3b, 0xfd, 0xd8, 0x26, 0x65, 0x93, 0x4d, 0x2a, 0x45, 0x93, 0x63, 0x2e, 0x3d, 0x39, 0xc4, 0x19,
+	0xc1, 0xfe, 0xa3, 0xa7, 0x78, 0x4e, 0x23, 0xfe, 0xb2, 0x3f, 0x94, 0xa0, 0x86, 0x51, 0xf0, 0x6f,
+	0xc2, 0xd4, 0xd2, 0x5c, 0x75, 0x62, 0x9a, 0x53, 0xda, 0xcd, 0x27, 0xda, 0x7d, 0x0d, 0x35, 0xe4,
+	0x9a, 0x4a, 0x39, 0xb5, 0x74, 0x56, 0x0c, 0x4b, 0xe7, 0x9c, 0x96, 0x93, 0x7f, 0x59, 0x86, 0x86,
+	0xb6, 0xf9, 0x9d, 0x4a, 0xf6, 0x1a, 0xcc, 0x63, 0xf9, 0x15, 0x49, 0xc7, 0x96, 0x18, 0xfa, 0xc0,
+	0x71, 0xcf, 0x79, 0x1f, 0x8b, 0xbf, 0xba, 0x15, 0xb7, 0xc5, 0x37, 0x58, 0xd1, 0x45, 0x16, 0xc7,
+	0x56, 0x62, 0xc4, 0x79, 0xdd, 0x88, 0xe9, 0x42, 0xa5, 0x56, 0x5c, 0xa8, 0x2c, 0xe8, 0x85, 0xca,
+	0x06, 0xd4, 0xe3, 0x22, 0x44, 0x16, 0x79, 0x55, 0x6b, 0x41, 0x15, 0x20, 0x99, 0xb2, 0x12, 0x32,
+	0x65, 0x25, 0xfb, 0x5b, 0x09, 0xe6, 0xc4, 0xb9, 0x66, 0xce, 0x00, 0xf9, 0x63, 0xad, 0xb2, 0xe9,
+	0x58, 0x2b, 0x9d, 0x66, 0x2a, 0xd9, 0x1d, 0xbe, 0x3a, 0xe9, 0x9d, 0x33, 0x9e, 0xf4, 0x56, 0xa7,
+	0x3e, 0xe9, 0x95, 0xe9, 0x46, 0x4e, 0xdb, 0xbe, 0xc8, 0xae, 0xf3, 0x51, 0x76, 0xc5, 0x9e, 0x7d,
+	0x99, 0x8d, 0xc6, 0xa3, 0xbe, 0x22, 0xd7, 0x22, 0x32, 0xf6, 0xec, 0x87, 0xec, 0x4f, 0x65, 0x80,
+	0xe4, 0xde, 0x48, 0x38, 0x25, 0xf0, 0xc6, 0xfe, 0xb1, 0xca, 0x2f, 0xd8, 0x12, 0x05, 0x8e, 0x3f,
+	0x76, 0x5d, 0xa1, 0x5a, 0xb4, 0x13, 0x56, 0xcd, 0xf8, 0x2e, 0xa9, 0x92, 0xdc, 0x25, 0x09, 0xcc,
+	0x81, 0x1d, 0x84, 0xbd, 0x20, 0xb4, 0xfd, 0x38, 0xe1, 0x8b, 0x9e, 0x03, 0xd1, 0x21, 0xb6, 0xd6,
+	0x92, 0x7c, 0xe2, 0xb8, 0x4e, 0x70, 0x26, 0xc7, 0x5a, 0xb1, 0xe4, 0x17, 0x5f, 0xc8, 0x1e, 0xc1,
+	0xd0, 0x1f, 0xfb, 0xf2, 0x12, 0xad, 0x37, 0x0c, 0x70, 0x4c, 0xa0, 0xba, 0xbe, 0x0a, 0x84, 0xb7,
+	0x03, 0xfb, 0x02, 0x4b, 0xc6, 0xaa, 0x15, 0x35, 0x84, 0x92, 0x7d, 0x99, 0x59, 0xfb, 0x32, 0x0a,
+	0xaa, 0x96, 0x6a, 0x8a, 0x38, 0x3c, 0xb1, 0x9d, 0xc1, 0xd8, 0xe7, 0x41, 0xbb, 0x2e, 0x8f, 0x71,
+	0xe3, 0x76, 0xac, 0x2c, 0xf7, 0x7d, 0xcf, 0x97, 0x61, 0x50, 0x8f, 0x94, 0xfd, 0x5c, 0x74, 0x88,
+	0x4f, 0x87, 0x8e, 0xf8, 0xc7, 0xfb, 0xed, 0x46, 0x14, 0x41, 0xaa, 0xfd, 0xe0, 0x2f, 0x5b, 0xb0,
+	0x84, 0xf9, 0xe1, 0x80, 0xfb, 0x17, 0xce, 0x31, 0x27, 0x47, 0xd0, 0xd0, 0x92, 0x28, 0x59, 0x57,
+	0xd3, 0x39, 0x97, 0xc8, 0x29, 0x35, 0x91, 0xa2, 0x6c, 0xc8, 0x3a, 0xdf, 0xfe, 0xf5, 0xef, 0xbf,
+	0x2b, 0xaf, 0xb1, 0xe5, 0xee, 0xc5, 0xfb, 0x5d, 0x8c, 0x99, 0xae, 0x4c, 0x82, 0x9f, 0x94, 0x76,
+	0x89, 0x0d, 0x0b, 0x2a, 0x7f, 0x92, 0x35, 0x94, 0x92, 0x49, 0xbf, 0xf4, 0x56, 0xae, 0x1f, 0x45,
+	0xbf, 0x29, 0x45, 0xdf, 0x26, 0x9d, 0x9c, 0xe8, 0xee, 0x4b, 0x95, 0xb0, 0x5f, 0x91, 0x6f, 0xa0,
+	0xa1, 0x2d, 0xb1, 0xf1, 0x30, 0xf2, 0xeb, 0x34, 0xa5, 0x26, 0x52, 0x1a, 0x6b, 0x77, 0x32, 0xd6,
+	0x10, 0x1a, 0x5a, 0xa5, 0x18, 0x63, 0xe5, 0x4b, 0x52, 0x4a, 0x4d, 0x24, 0xc4, 0xba, 0x2f, 0xb1,
+	0xee, 0xd0, 0x89, 0x58, 0xc2, 0x7a, 0x1c, 0x20, 0xd9, 0x02, 0x91, 0x36, 0x8a, 0xcc, 0xed, 0xae,
+	0xe8, 0xba, 0x81, 0x82, 0x58, 0x4c, 0x62, 0x75, 0xd8, 0xad, 0x3c, 0xd6, 0x40, 0x70, 0x0b, 0x98,
+	0x73, 0x68, 0xa6, 0x6e, 0x6f, 0xc9, 0x46, 0xe2, 0x91, 0xdc, 0x5d, 0x2f, 0xed, 0x98, 0x89, 0x88,
+	0xb7, 0x25, 0xf1, 0xd6, 0x49, 0x0a, 0x2f, 0xb8, 0x72, 0x8f, 0xbb, 0xd1, 0x35, 0x2f, 0xb1, 0xa1,
+	0xa1, 0x5d, 0xde, 0xc6, 0x26, 0xcc, 0x5f, 0xfd, 0x52, 0x6a, 0x22, 0x21, 0xcc, 0x86, 0x84, 0x59,
+	0x65, 0x37, 0xb3, 0x30, 0x62, 0x3c, 0x47, 0xd0, 0x4c, 0x95, 0xd4, 0xf1, 0x78, 0x4c, 0xa5, 0x3a,
+	0xed, 0x98, 0x89, 0x08, 0xb4, 0x26, 0x81, 0x6e, 0xb2, 0x86, 0x06, 0x24, 0x30, 0xce, 0x00, 0x92,
+	0x5b, 0x9f, 0xd8, 0x35, 0xb9, 0xcb, 0x23, 0xba, 0x6e, 0xa0, 0xa0, 0xe8, 0xbb, 0x52, 0xf4, 0x16,
+	0xd9, 0xd4, 0xc7, 0xf0, 0x32, 0x49, 0xc4, 0xaf, 0xba, 0x8e, 0x7b, 0xe2, 0x11, 0x0f, 0x9a, 0xa9,
+	0x53, 0x93, 0x78, 0x34, 0xa6, 0xc3, 0x1a, 0xda, 0x31, 0x13, 0x11, 0xf2, 0x0d, 0x09, 0xb9, 0x49,
+	0xdb, 0x45, 0x90, 0x62, 0x68, 0x03, 0x68, 0xa6, 0x76, 0x95, 0x31, 0xa0, 0x69, 0x8b, 0x4a, 0x3b,
+	0x66, 0x22, 0x02, 0x6e, 0x4b, 0x40, 0xba, 0x5b, 0x08, 0x48, 0xbe, 0x51, 0xce, 0x52, 0x1b, 0x84,
+	0xb4, 0xb3, 0xd2, 0x1b, 0x56, 0xda, 0x31, 0x13, 0x11, 0xed, 0xb6, 0x44, 0x6b, 0xb3, 0x15, 0x1d,
+	0x0d, 0x77, 0x25, 0x51, 0xa0, 0x43, 0x52, 0xf3, 0xea, 0x4e, 0xcb, 0xa0, 0xac, 0x1b, 0x28, 0x08,
+	0xb1, 0x23, 0x21, 0x18, 0xd9, 0x36, 0x40, 0x74, 0x5f, 0x26, 0x9b, 0xe9, 0x57, 0xe4, 0x85, 0xf2,
+	0x5b, 0x76, 0x60, 0xa6, 0xb2, 0x99, 0x76, 0xcc, 0x44, 0x44, 0x7d, 0x5b, 0xa2, 0xde, 0xa5, 0xd7,
+	0xa2, 0x8a, 0x51, 0xfe, 0xa6, 0x04, 0x2b, 0x86, 0xa2, 0x8f, 0xdc, 0x51, 0x59, 0xa2, 0xb0, 0x04,
+	0xa5, 0x6c, 0x12, 0x0b, 0xea, 0xf2, 0xbe, 0xd4, 0xe5, 0x6d, 0xf2, 0xd6, 0x75, 0xba, 0x74, 0xe3,
+	0xd2, 0xf1, 0xe7, 0xb0, 0x94, 0xae, 0x9d, 0x49, 0x27, 0x3e, 0xfd, 0x34, 0x94, 0xe7, 0x74, 0xb3,
+	0x80, 0x8a, 0x1a, 0xbc, 0x27, 0x35, 0xd8, 0xa5, 0x77, 0xaf, 0xd5, 0x40, 0xec, 0x12, 0x85, 0x49,
+	0x42, 0x15, 0xd2, 0x59, 0x5f, 0x98, 0xaa, 0x22, 0xda, 0x31, 0x13, 0xd3, 0x11, 0xb0, 0x7b, 0x7d,
+	0x04, 0x70, 0x58, 0xd4, 0xaf, 0x9f, 0x08, 0xd5, 0xd3, 0x74, 0x66, 0x1a, 0x6d, 0x18, 0x69, 0x93,
+	0xd6, 0xd8, 0x38, 0x7d, 0x0f, 0xc5, 0x9d, 0xa7, 0x56, 0xc3, 0x2a, 0x18, 0xc3, 0xf5, 0x12, 0xdd,
+	0x30, 0xd2, 0xd2, 0x6b, 0x20, 0x5b, 0xd7, 0x61, 0x64, 0xad, 0xdc, 0xc5, 0x22, 0x19, 0xe1, 0xf4,
+	0x33, 0xc0, 0x18, 0xce, 0x70, 0xc2, 0x48, 0x37, 0x8c, 0xb4, 0x49, 0x70, 0xd1, 0xa9, 0x65, 0xd7,
+	0x96, 0x1f, 0x08, 0xb8, 0x5f, 0x97, 0xa0, 0x85, 0xe2, 0x52, 0xc7, 0x82, 0x84, 0xc5, 0x43, 0x29,
+	0x3c, 0x68, 0xa4, 0x6f, 0x4c, 0xe4, 0x41, 0x3d, 0xde, 0x95, 0x7a, 0xdc, 0x67, 0x4c, 0xd7, 0x43,
+	0x95, 0x3d, 0x5d, 0x5f, 0xf0, 0x76, 0xfd, 0x48, 0x80, 0x50, 0xe8, 0x39, 0x34, 0x53, 0x87, 0x85,
+	0x24, 0xb1, 0x69, 0xfe, 0x30, 0x92, 0x76, 0xcc, 0xc4, 0xf4, 0x12, 0xc0, 0x68, 0x31, 0xb4, 0x80,
+	0x3c, 0x84, 0xf9, 0xe8, 0x9e, 0x8c, 0xb4, 0x92, 0x30, 0x49, 0x2e, 0xe3, 0xe8, 0x6a, 0xa6, 0x17,
+	0xa5, 0x6f, 0x4a, 0xe9, 0xb7, 0x18, 0xc9, 0x84, 0x8d, 0x37, 0x0e, 0xa3, 0x65, 0xb2, 0x86, 0x77,
+	0xe5, 0x64, 0x35, 0xd1, 0x52, 0x7b, 0x87, 0x40, 0xd7, 0xb2, 0xdd, 0x93, 0x92, 0xa0, 0x7e, 0x3d,
+	0xd4, 0xf5, 0x51, 0xf0, 0xff, 0x42, 0xf3, 0x20, 0xf4, 0xb9, 0x3d, 0xbc, 0x06, 0x69, 0x25, 0xdd,
+	0x2d, 0x2f, 0xef, 0xd9, 0x7f, 0xbd, 0x57, 0x22, 0x97, 0x50, 0x8f, 0x6f, 0xd6, 0x89, 0xb6, 0x53,
+	0x4c, 0x5d, 0xdf, 0xd3, 0x76, 0x9e, 0x80, 0xaa, 0xfe, 0x8f, 0x54, 0xf5, 0x01, 0x79, 0xaf, 0x50,
+	0xd5, 0x97, 0xe9, 0x0a, 0xe9, 0x15, 0xea, 0x4e, 0x3c, 0x58, 0x4a, 0x3f, 0xff, 0x8a, 0x93, 0x96,
+	0xf1, 0x55, 0x19, 0xdd, 0x2c, 0xa0, 0xa6, 0x57, 0x42, 0xb6, 0xaa, 0x2b, 0x12, 0xdf, 0x1f, 0x09,
+	0x7f, 0xfc, 0xaa, 0x04, 0x2b, 0x86, 0x17, 0x60, 0x71, 0xde, 0x2e, 0x7e, 0x62, 0x46, 0xd9, 0x24,
+	0x16, 0x54, 0x60, 0x4f, 0x2a, 0xb0, 0xb3, 0x7b, 0xcf, 0xa8, 0x40, 0xce, 0x0c, 0xc4, 0x81, 0x66,
+	0xea, 0x4d, 0x58, 0x1c, 0xe7, 0xa6, 0x67, 0x67, 0xb4, 0x63, 0x26, 0xa6, 0x23, 0x91, 0x98, 0x07,
+	0x4f, 0x5e, 0x01, 0x24, 0xef, 0xaf, 0xe2, 0x75, 0x39, 0xf7, 0x2e, 0x8c, 0xae, 0x1b, 0x28, 0x88,
+	0xf0, 0x89, 0x44, 0xf8, 0x80, 0x75, 0xa7, 0xf7, 0xb3, 0x7c, 0x1c, 0x25, 0x0c, 0xff, 0x02, 0xea,
+	0xf1, 0xbb, 0xaa, 0x38, 0xc6, 0xb2, 0x2f, 0xbb, 0x68, 0x3b, 0x4f, 0x40, 0xec, 0xff, 0x96, 0xd8,
+	0xef, 0x93, 0x59, 0xb1, 0x89, 0x03, 0x90, 0xbc, 0x7c, 0x8a, 0xc7, 0x9d, 0x7b, 0x83, 0x45, 0xd7,
+	0x0d, 0x14, 0xc4, 0xbe, 0x27, 0xb1, 0xb7, 0xe9, 0x86, 0x8e, 0x2d, 0xa5, 0x77, 0x5f, 0xe2, 0x4b,
+	0x28, 0xb9, 0x29, 0x38, 0x01, 0x48, 0xde, 0x3f, 0xc5, 0x50, 0xb9, 0x07, 0x54, 0x74, 0xdd, 0x40,
+	0x49, 0x6f, 0x1e, 0x77, 0x27, 0x41, 0x91, 0x71, 0xf4, 0x26, 0x4e, 0xbf, 0x03, 0x25, 0xb7, 0x35,
+	0xcb, 0x19, 0x6e, 0x81, 0xe9, 0x56, 0x21, 0x3d, 0x3d, 0x77, 0x48, 0xbb, 0xc8, 0xc0, 0xe4, 0xdb,
+	0x12, 0x90, 0xfc, 0xed, 0x2b, 0xd9, 0x4e, 0x5d, 0xb2, 0x9a, 0xb0, 0xef, 0x4c, 0xe0, 0x48, 0x97,
+	0x6b, 0x64, 0xab, 0xd0, 0xbd, 0x81, 0xfc, 0x58, 0xcd, 0x98, 0xf8, 0x65, 0x4c, 0x6a, 0xc6, 0x64,
+	0xdf, 0xcb, 0xd0, 0x8e, 0x99, 0x38, 0x69, 0xc6, 0xc4, 0x8f, 0x75, 0x8e, 0xe6, 0xe5, 0xb7, 0x0f,
+	0xff, 0x39, 0x00, 0xce, 0x92, 0x7e, 0x50, 0xe5, 0x2b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SponsorServiceClient is the client API for SponsorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SponsorServiceClient interface {
+	//
+	// ================================================================
+	// ADMIN RPC CALLS
+	// ================================================================
+	CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error)
+	GetAdmin(ctx context.Context, in *GetAdminRequest, opts ...grpc.CallOption) (*GetAdminResponse, error)
+	DeleteAdmin(ctx context.Context, in *DeleteAdminRequest, opts ...grpc.CallOption) (*DeleteAdminResponse, error)
+	UpdateAdmin(ctx context.Context, in *UpdateAdminRequest, opts ...grpc.CallOption) (*UpdateAdminResponse, error)
+	LoginAdmin(ctx context.Context, in *LoginAdminRequest, opts ...grpc.CallOption) (*LoginAdminResponse, error)
+	GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error)
+	TriggerSync(ctx context.Context, in *TriggerSyncRequest, opts ...grpc.CallOption) (*TriggerSyncResponse, error)
+	//
+	// ================================================================
+	// SPONSOR RPC CALLS
+	// ================================================================
+	CreateSponsor(ctx context.Context, in *CreateSponsorRequest, opts ...grpc.CallOption) (*CreateSponsorResponse, error)
+	GetSponsor(ctx context.Context, in *GetSponsorRequest, opts ...grpc.CallOption) (*GetSponsorResponse, error)
+	UpdateSponsor(ctx context.Context, in *UpdateSponsorRequest, opts ...grpc.CallOption) (*UpdateSponsorResponse, error)
+	// DeleteSponsor soft deletes a sponsor, it can only be called by an admin
+	DeleteSponsor(ctx context.Context, in *DeleteSponsorRequest, opts ...grpc.CallOption) (*DeleteSponsorResponse, error)
+	CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*CreateCompanyResponse, error)
+	GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*GetCompanyResponse, error)
+	// UpdateCompany changes the name and the logo of a company, sponsors can
+	// only update their own company
+	UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*UpdateCompanyResponse, error)
+	// ListCompanySponsors lists the sponsors of a company, sponsors can only
+	// list the sponsors of their own company
+	ListCompanySponsors(ctx context.Context, in *ListCompanySponsorsRequest, opts ...grpc.CallOption) (*ListCompanySponsorsResponse, error)
+	// SetCompanyTier changes the sponsorship tier of a company, it can only
+	// be called by an admin
+	SetCompanyTier(ctx context.Context, in *SetCompanyTierRequest, opts ...grpc.CallOption) (*SetCompanyTierResponse, error)
+	// DeleteCompany soft deletes a company along with all of its sponsors,
+	// it can only be called by an admin
+	DeleteCompany(ctx context.Context, in *DeleteCompanyRequest, opts ...grpc.CallOption) (*DeleteCompanyResponse, error)
+	LoginSponsor(ctx context.Context, in *LoginSponsorRequest, opts ...grpc.CallOption) (*LoginSponsorResponse, error)
+	// RefreshToken exchanges a refresh token for a new access token and a new
+	// refresh token, a refresh token can only be used once
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	// AcceptInvite sets the password of a sponsor that was created without
+	// one using the token from the invite email
+	AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AcceptInviteResponse, error)
+	// RequestPasswordReset emails a password reset token to the admin or
+	// sponsor with the email, it succeeds even when there is no such user
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	// ResetPassword sets a new password using the token from the password
+	// reset email and revokes all the sessions of the user
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	// Logout revokes the access token of the request along with the refresh
+	// token, every session of the user is revoked when everywhere is set
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	Resumes(ctx context.Context, in *ResumesRequest, opts ...grpc.CallOption) (*ResumesResponse, error)
+	// StreamResumes streams an archive of the resumes in chunks as every
+	// resume is downloaded. The gateway serves it as a file download at
+	// /v1/sponsor/participants/resumes/archive
+	StreamResumes(ctx context.Context, in *ResumesRequest, opts ...grpc.CallOption) (SponsorService_StreamResumesClient, error)
+	// GetResume returns the resume of a single participant, either the
+	// resume itself or a short lived signed URL that serves the resume
+	// without any other authentication
+	GetResume(ctx context.Context, in *GetResumeRequest, opts ...grpc.CallOption) (*GetResumeResponse, error)
+	AddToShortlist(ctx context.Context, in *AddToShortlistRequest, opts ...grpc.CallOption) (*AddToShortlistResponse, error)
+	RemoveFromShortlist(ctx context.Context, in *RemoveFromShortlistRequest, opts ...grpc.CallOption) (*RemoveFromShortlistResponse, error)
+	ListShortlist(ctx context.Context, in *ListShortlistRequest, opts ...grpc.CallOption) (*ListShortlistResponse, error)
+	CreateNote(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*CreateNoteResponse, error)
+	ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error)
+	UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error)
+	DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error)
+	ListParticipants(ctx context.Context, in *ListParticipantsRequest, opts ...grpc.CallOption) (*ListParticipantsResponse, error)
+	// SearchParticipants ranks the participants by how well their resume,
+	// name, major and university match the query
+	SearchParticipants(ctx context.Context, in *SearchParticipantsRequest, opts ...grpc.CallOption) (*SearchParticipantsResponse, error)
+	ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error)
+}
+
+type sponsorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSponsorServiceClient(cc *grpc.ClientConn) SponsorServiceClient {
+	return &sponsorServiceClient{cc}
+}
+
+func (c *sponsorServiceClient) CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error) {
+	out := new(CreateAdminResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/CreateAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) GetAdmin(ctx context.Context, in *GetAdminRequest, opts ...grpc.CallOption) (*GetAdminResponse, error) {
+	out := new(GetAdminResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/GetAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) DeleteAdmin(ctx context.Context, in *DeleteAdminRequest, opts ...grpc.CallOption) (*DeleteAdminResponse, error) {
+	out := new(DeleteAdminResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/DeleteAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) UpdateAdmin(ctx context.Context, in *UpdateAdminRequest, opts ...grpc.CallOption) (*UpdateAdminResponse, error) {
+	out := new(UpdateAdminResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/UpdateAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) LoginAdmin(ctx context.Context, in *LoginAdminRequest, opts ...grpc.CallOption) (*LoginAdminResponse, error) {
+	out := new(LoginAdminResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/LoginAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error) {
+	out := new(GetSyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/GetSyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) TriggerSync(ctx context.Context, in *TriggerSyncRequest, opts ...grpc.CallOption) (*TriggerSyncResponse, error) {
+	out := new(TriggerSyncResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/TriggerSync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) CreateSponsor(ctx context.Context, in *CreateSponsorRequest, opts ...grpc.CallOption) (*CreateSponsorResponse, error) {
+	out := new(CreateSponsorResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/CreateSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) GetSponsor(ctx context.Context, in *GetSponsorRequest, opts ...grpc.CallOption) (*GetSponsorResponse, error) {
+	out := new(GetSponsorResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/GetSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) UpdateSponsor(ctx context.Context, in *UpdateSponsorRequest, opts ...grpc.CallOption) (*UpdateSponsorResponse, error) {
+	out := new(UpdateSponsorResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/UpdateSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) DeleteSponsor(ctx context.Context, in *DeleteSponsorRequest, opts ...grpc.CallOption) (*DeleteSponsorResponse, error) {
+	out := new(DeleteSponsorResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/DeleteSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*CreateCompanyResponse, error) {
+	out := new(CreateCompanyResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/CreateCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*GetCompanyResponse, error) {
+	out := new(GetCompanyResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/GetCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*UpdateCompanyResponse, error) {
+	out := new(UpdateCompanyResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/UpdateCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) ListCompanySponsors(ctx context.Context, in *ListCompanySponsorsRequest, opts ...grpc.CallOption) (*ListCompanySponsorsResponse, error) {
+	out := new(ListCompanySponsorsResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/ListCompanySponsors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) SetCompanyTier(ctx context.Context, in *SetCompanyTierRequest, opts ...grpc.CallOption) (*SetCompanyTierResponse, error) {
+	out := new(SetCompanyTierResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/SetCompanyTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) DeleteCompany(ctx context.Context, in *DeleteCompanyRequest, opts ...grpc.CallOption) (*DeleteCompanyResponse, error) {
+	out := new(DeleteCompanyResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/DeleteCompany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) LoginSponsor(ctx context.Context, in *LoginSponsorRequest, opts ...grpc.CallOption) (*LoginSponsorResponse, error) {
+	out := new(LoginSponsorResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/LoginSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*AcceptInviteResponse, error) {
+	out := new(AcceptInviteResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) Resumes(ctx context.Context, in *ResumesRequest, opts ...grpc.CallOption) (*ResumesResponse, error) {
+	out := new(ResumesResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/Resumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) StreamResumes(ctx context.Context, in *ResumesRequest, opts ...grpc.CallOption) (SponsorService_StreamResumesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SponsorService_serviceDesc.Streams[0], "/proto.SponsorService/StreamResumes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sponsorServiceStreamResumesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SponsorService_StreamResumesClient interface {
+	Recv() (*ResumesChunk, error)
+	grpc.ClientStream
+}
+
+type sponsorServiceStreamResumesClient struct {
+	grpc.ClientStream
+}
+
+func (x *sponsorServiceStreamResumesClient) Recv() (*ResumesChunk, error) {
+	m := new(ResumesChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sponsorServiceClient) GetResume(ctx context.Context, in *GetResumeRequest, opts ...grpc.CallOption) (*GetResumeResponse, error) {
+	out := new(GetResumeResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/GetResume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) AddToShortlist(ctx context.Context, in *AddToShortlistRequest, opts ...grpc.CallOption) (*AddToShortlistResponse, error) {
+	out := new(AddToShortlistResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/AddToShortlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) RemoveFromShortlist(ctx context.Context, in *RemoveFromShortlistRequest, opts ...grpc.CallOption) (*RemoveFromShortlistResponse, error) {
+	out := new(RemoveFromShortlistResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/RemoveFromShortlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) ListShortlist(ctx context.Context, in *ListShortlistRequest, opts ...grpc.CallOption) (*ListShortlistResponse, error) {
+	out := new(ListShortlistResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/ListShortlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) CreateNote(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*CreateNoteResponse, error) {
+	out := new(CreateNoteResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/CreateNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error) {
+	out := new(ListNotesResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/ListNotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) UpdateNote(ctx context.Context, in *UpdateNoteRequest, opts ...grpc.CallOption) (*UpdateNoteResponse, error) {
+	out := new(UpdateNoteResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/UpdateNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error) {
+	out := new(DeleteNoteResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/DeleteNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) ListParticipants(ctx context.Context, in *ListParticipantsRequest, opts ...grpc.CallOption) (*ListParticipantsResponse, error) {
+	out := new(ListParticipantsResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/ListParticipants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) SearchParticipants(ctx context.Context, in *SearchParticipantsRequest, opts ...grpc.CallOption) (*SearchParticipantsResponse, error) {
+	out := new(SearchParticipantsResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/SearchParticipants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sponsorServiceClient) ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error) {
+	out := new(ListCompaniesResponse)
+	err := c.cc.Invoke(ctx, "/proto.SponsorService/ListCompanies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SponsorServiceServer is the server API for SponsorService service.
+type SponsorServiceServer interface {
+	//
+	// ================================================================
+	// ADMIN RPC CALLS
+	// ================================================================
+	CreateAdmin(context.Context, *CreateAdminRequest) (*CreateAdminResponse, error)
+	GetAdmin(context.Context, *GetAdminRequest) (*GetAdminResponse, error)
+	DeleteAdmin(context.Context, *DeleteAdminRequest) (*DeleteAdminResponse, error)
+	UpdateAdmin(context.Context, *UpdateAdminRequest) (*UpdateAdminResponse, error)
+	LoginAdmin(context.Context, *LoginAdminRequest) (*LoginAdminResponse, error)
+	GetSyncStatus(context.Context, *GetSyncStatusRequest) (*GetSyncStatusResponse, error)
+	TriggerSync(context.Context, *TriggerSyncRequest) (*TriggerSyncResponse, error)
+	//
+	// ================================================================
+	// SPONSOR RPC CALLS
+	// ================================================================
+	CreateSponsor(context.Context, *CreateSponsorRequest) (*CreateSponsorResponse, error)
+	GetSponsor(context.Context, *GetSponsorRequest) (*GetSponsorResponse, error)
+	UpdateSponsor(context.Context, *UpdateSponsorRequest) (*UpdateSponsorResponse, error)
+	// DeleteSponsor soft deletes a sponsor, it can only be called by an admin
+	DeleteSponsor(context.Context, *DeleteSponsorRequest) (*DeleteSponsorResponse, error)
+	CreateCompany(context.Context, *CreateCompanyRequest) (*CreateCompanyResponse, error)
+	GetCompany(context.Context, *GetCompanyRequest) (*GetCompanyResponse, error)
+	// UpdateCompany changes the name and the logo of a company, sponsors can
+	// only update their own company
+	UpdateCompany(context.Context, *UpdateCompanyRequest) (*UpdateCompanyResponse, error)
+	// ListCompanySponsors lists the sponsors of a company, sponsors can only
+	// list the sponsors of their own company
+	ListCompanySponsors(context.Context, *ListCompanySponsorsRequest) (*ListCompanySponsorsResponse, error)
+	// SetCompanyTier changes the sponsorship tier of a company, it can only
+	// be called by an admin
+	SetCompanyTier(context.Context, *SetCompanyTierRequest) (*SetCompanyTierResponse, error)
+	// DeleteCompany soft deletes a company along with all of its sponsors,
+	// it can only be called by an admin
+	DeleteCompany(context.Context, *DeleteCompanyRequest) (*DeleteCompanyResponse, error)
+	LoginSponsor(context.Context, *LoginSponsorRequest) (*LoginSponsorResponse, error)
+	// RefreshToken exchanges a refresh token for a new access token and a new
+	// refresh token, a refresh token can only be used once
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// AcceptInvite sets the password of a sponsor that was created without
+	// one using the token from the invite email
+	AcceptInvite(context.Context, *AcceptInviteRequest) (*AcceptInviteResponse, error)
+	// RequestPasswordReset emails a password reset token to the admin or
+	// sponsor with the email, it succeeds even when there is no such user
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	// ResetPassword sets a new password using the token from the password
+	// reset email and revokes all the sessions of the user
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	// Logout revokes the access token of the request along with the refresh
+	// token, every session of the user is revoked when everywhere is set
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	Resumes(context.Context, *ResumesRequest) (*ResumesResponse, error)
+	// StreamResumes streams an archive of the resumes in chunks as every
+	// resume is downloaded. The gateway serves it as a file download at
+	// /v1/sponsor/participants/resumes/archive
+	StreamResumes(*ResumesRequest, SponsorService_StreamResumesServer) error
+	// GetResume returns the resume of a single participant, either the
+	// resume itself or a short lived signed URL that serves the resume
+	// without any other authentication
+	GetResume(context.Context, *GetResumeRequest) (*GetResumeResponse, error)
+	AddToShortlist(context.Context, *AddToShortlistRequest) (*AddToShortlistResponse, error)
+	RemoveFromShortlist(context.Context, *RemoveFromShortlistRequest) (*RemoveFromShortlistResponse, error)
+	ListShortlist(context.Context, *ListShortlistRequest) (*ListShortlistResponse, error)
+	CreateNote(context.Context, *CreateNoteRequest) (*CreateNoteResponse, error)
+	ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error)
+	UpdateNote(context.Context, *UpdateNoteRequest) (*UpdateNoteResponse, error)
+	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
+	ListParticipants(context.Context, *ListParticipantsRequest) (*ListParticipantsResponse, error)
+	// SearchParticipants ranks the participants by how well their resume,
+	// name, major and university match the query
+	SearchParticipants(context.Context, *SearchParticipantsRequest) (*SearchParticipantsResponse, error)
+	ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesResponse, error)
+}
+
+func RegisterSponsorServiceServer(s *grpc.Server, srv SponsorServiceServer) {
+	s.RegisterService(&_SponsorService_serviceDesc, srv)
+}
+
+func _SponsorService_CreateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).CreateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/CreateAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).CreateAdmin(ctx, req.(*CreateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_GetAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).GetAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/GetAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).GetAdmin(ctx, req.(*GetAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_DeleteAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).DeleteAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/DeleteAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).DeleteAdmin(ctx, req.(*DeleteAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_UpdateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).UpdateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/UpdateAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).UpdateAdmin(ctx, req.(*UpdateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_LoginAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).LoginAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/LoginAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).LoginAdmin(ctx, req.(*LoginAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/GetSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).GetSyncStatus(ctx, req.(*GetSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_TriggerSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).TriggerSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/TriggerSync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).TriggerSync(ctx, req.(*TriggerSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_CreateSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSponsorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).CreateSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/CreateSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).CreateSponsor(ctx, req.(*CreateSponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_GetSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSponsorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).GetSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/GetSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).GetSponsor(ctx, req.(*GetSponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_UpdateSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSponsorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).UpdateSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/UpdateSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).UpdateSponsor(ctx, req.(*UpdateSponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_DeleteSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSponsorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).DeleteSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/DeleteSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).DeleteSponsor(ctx, req.(*DeleteSponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).CreateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/CreateCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).CreateCompany(ctx, req.(*CreateCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_GetCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).GetCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/GetCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).GetCompany(ctx, req.(*GetCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SponsorService_UpdateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).UpdateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/UpdateCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).UpdateCompany(ctx, req.(*UpdateCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *sponsorServiceClient) GetSponsor(ctx context.Context, in *GetSponsorRequest, opts ...grpc.CallOption) (*GetSponsorResponse, error) {
-	out := new(GetSponsorResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/GetSponsor", in, out, opts...)
-	if err != nil {
+func _SponsorService_ListCompanySponsors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompanySponsorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).ListCompanySponsors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/ListCompanySponsors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).ListCompanySponsors(ctx, req.(*ListCompanySponsorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *sponsorServiceClient) UpdateSponsor(ctx context.Context, in *UpdateSponsorRequest, opts ...grpc.CallOption) (*UpdateSponsorResponse, error) {
-	out := new(UpdateSponsorResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/UpdateSponsor", in, out, opts...)
-	if err != nil {
+func _SponsorService_SetCompanyTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCompanyTierRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).SetCompanyTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/SetCompanyTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).SetCompanyTier(ctx, req.(*SetCompanyTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *sponsorServiceClient) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*CreateCompanyResponse, error) {
-	out := new(CreateCompanyResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/CreateCompany", in, out, opts...)
-	if err != nil {
+func _SponsorService_DeleteCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompanyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).DeleteCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/DeleteCompany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).DeleteCompany(ctx, req.(*DeleteCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *sponsorServiceClient) LoginSponsor(ctx context.Context, in *LoginSponsorRequest, opts ...grpc.CallOption) (*LoginSponsorResponse, error) {
-	out := new(LoginSponsorResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/LoginSponsor", in, out, opts...)
-	if err != nil {
+func _SponsorService_LoginSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginSponsorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).LoginSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/LoginSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).LoginSponsor(ctx, req.(*LoginSponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *sponsorServiceClient) Resumes(ctx context.Context, in *ResumesRequest, opts ...grpc.CallOption) (*ResumesResponse, error) {
-	out := new(ResumesResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/Resumes", in, out, opts...)
-	if err != nil {
+func _SponsorService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *sponsorServiceClient) ListParticipants(ctx context.Context, in *ListParticipantsRequest, opts ...grpc.CallOption) (*ListParticipantsResponse, error) {
-	out := new(ListParticipantsResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/ListParticipants", in, out, opts...)
-	if err != nil {
+func _SponsorService_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/AcceptInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).AcceptInvite(ctx, req.(*AcceptInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *sponsorServiceClient) ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error) {
-	out := new(ListCompaniesResponse)
-	err := c.cc.Invoke(ctx, "/proto.SponsorService/ListCompanies", in, out, opts...)
-	if err != nil {
+func _SponsorService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// SponsorServiceServer is the server API for SponsorService service.
-type SponsorServiceServer interface {
-	//
-	//================================================================
-	//ADMIN RPC CALLS
-	//================================================================
-	CreateAdmin(context.Context, *CreateAdminRequest) (*CreateAdminResponse, error)
-	GetAdmin(context.Context, *GetAdminRequest) (*GetAdminResponse, error)
-	DeleteAdmin(context.Context, *DeleteAdminRequest) (*DeleteAdminResponse, error)
-	UpdateAdmin(context.Context, *UpdateAdminRequest) (*UpdateAdminResponse, error)
-	LoginAdmin(context.Context, *LoginAdminRequest) (*LoginAdminResponse, error)
-	//
-	//================================================================
-	//SPONSOR RPC CALLS
-	//================================================================
-	CreateSponsor(context.Context, *CreateSponsorRequest) (*CreateSponsorResponse, error)
-	GetSponsor(context.Context, *GetSponsorRequest) (*GetSponsorResponse, error)
-	UpdateSponsor(context.Context, *UpdateSponsorRequest) (*UpdateSponsorResponse, error)
-	CreateCompany(context.Context, *CreateCompanyRequest) (*CreateCompanyResponse, error)
-	LoginSponsor(context.Context, *LoginSponsorRequest) (*LoginSponsorResponse, error)
-	Resumes(context.Context, *ResumesRequest) (*ResumesResponse, error)
-	ListParticipants(context.Context, *ListParticipantsRequest) (*ListParticipantsResponse, error)
-	ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesResponse, error)
-}
-
-func RegisterSponsorServiceServer(s *grpc.Server, srv SponsorServiceServer) {
-	s.RegisterService(&_SponsorService_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_CreateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAdminRequest)
+func _SponsorService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).CreateAdmin(ctx, in)
+		return srv.(SponsorServiceServer).ResetPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/CreateAdmin",
+		FullMethod: "/proto.SponsorService/ResetPassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).CreateAdmin(ctx, req.(*CreateAdminRequest))
+		return srv.(SponsorServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_GetAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAdminRequest)
+func _SponsorService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).GetAdmin(ctx, in)
+		return srv.(SponsorServiceServer).Logout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/GetAdmin",
+		FullMethod: "/proto.SponsorService/Logout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).GetAdmin(ctx, req.(*GetAdminRequest))
+		return srv.(SponsorServiceServer).Logout(ctx, req.(*LogoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_DeleteAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAdminRequest)
+func _SponsorService_Resumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).DeleteAdmin(ctx, in)
+		return srv.(SponsorServiceServer).Resumes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/DeleteAdmin",
+		FullMethod: "/proto.SponsorService/Resumes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).DeleteAdmin(ctx, req.(*DeleteAdminRequest))
+		return srv.(SponsorServiceServer).Resumes(ctx, req.(*ResumesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_UpdateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAdminRequest)
+func _SponsorService_StreamResumes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SponsorServiceServer).StreamResumes(m, &sponsorServiceStreamResumesServer{stream})
+}
+
+type SponsorService_StreamResumesServer interface {
+	Send(*ResumesChunk) error
+	grpc.ServerStream
+}
+
+type sponsorServiceStreamResumesServer struct {
+	grpc.ServerStream
+}
+
+func (x *sponsorServiceStreamResumesServer) Send(m *ResumesChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SponsorService_GetResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).UpdateAdmin(ctx, in)
+		return srv.(SponsorServiceServer).GetResume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/UpdateAdmin",
+		FullMethod: "/proto.SponsorService/GetResume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).UpdateAdmin(ctx, req.(*UpdateAdminRequest))
+		return srv.(SponsorServiceServer).GetResume(ctx, req.(*GetResumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_LoginAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginAdminRequest)
+func _SponsorService_AddToShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToShortlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).LoginAdmin(ctx, in)
+		return srv.(SponsorServiceServer).AddToShortlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/LoginAdmin",
+		FullMethod: "/proto.SponsorService/AddToShortlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).LoginAdmin(ctx, req.(*LoginAdminRequest))
+		return srv.(SponsorServiceServer).AddToShortlist(ctx, req.(*AddToShortlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_CreateSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSponsorRequest)
+func _SponsorService_RemoveFromShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromShortlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).CreateSponsor(ctx, in)
+		return srv.(SponsorServiceServer).RemoveFromShortlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/CreateSponsor",
+		FullMethod: "/proto.SponsorService/RemoveFromShortlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).CreateSponsor(ctx, req.(*CreateSponsorRequest))
+		return srv.(SponsorServiceServer).RemoveFromShortlist(ctx, req.(*RemoveFromShortlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_GetSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSponsorRequest)
+func _SponsorService_ListShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).GetSponsor(ctx, in)
+		return srv.(SponsorServiceServer).ListShortlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/GetSponsor",
+		FullMethod: "/proto.SponsorService/ListShortlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).GetSponsor(ctx, req.(*GetSponsorRequest))
+		return srv.(SponsorServiceServer).ListShortlist(ctx, req.(*ListShortlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_UpdateSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSponsorRequest)
+func _SponsorService_CreateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).UpdateSponsor(ctx, in)
+		return srv.(SponsorServiceServer).CreateNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/UpdateSponsor",
+		FullMethod: "/proto.SponsorService/CreateNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).UpdateSponsor(ctx, req.(*UpdateSponsorRequest))
+		return srv.(SponsorServiceServer).CreateNote(ctx, req.(*CreateNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCompanyRequest)
+func _SponsorService_ListNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).CreateCompany(ctx, in)
+		return srv.(SponsorServiceServer).ListNotes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/CreateCompany",
+		FullMethod: "/proto.SponsorService/ListNotes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).CreateCompany(ctx, req.(*CreateCompanyRequest))
+		return srv.(SponsorServiceServer).ListNotes(ctx, req.(*ListNotesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_LoginSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginSponsorRequest)
+func _SponsorService_UpdateNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).LoginSponsor(ctx, in)
+		return srv.(SponsorServiceServer).UpdateNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/LoginSponsor",
+		FullMethod: "/proto.SponsorService/UpdateNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).LoginSponsor(ctx, req.(*LoginSponsorRequest))
+		return srv.(SponsorServiceServer).UpdateNote(ctx, req.(*UpdateNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SponsorService_Resumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResumesRequest)
+func _SponsorService_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SponsorServiceServer).Resumes(ctx, in)
+		return srv.(SponsorServiceServer).DeleteNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.SponsorService/Resumes",
+		FullMethod: "/proto.SponsorService/DeleteNote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SponsorServiceServer).Resumes(ctx, req.(*ResumesRequest))
+		return srv.(SponsorServiceServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1931,6 +5339,24 @@ func _SponsorService_ListParticipants_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SponsorService_SearchParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SponsorServiceServer).SearchParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SponsorService/SearchParticipants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SponsorServiceServer).SearchParticipants(ctx, req.(*SearchParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SponsorService_ListCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCompaniesRequest)
 	if err := dec(in); err != nil {
@@ -1973,6 +5399,14 @@ var _SponsorService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LoginAdmin",
 			Handler:    _SponsorService_LoginAdmin_Handler,
 		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _SponsorService_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "TriggerSync",
+			Handler:    _SponsorService_TriggerSync_Handler,
+		},
 		{
 			MethodName: "CreateSponsor",
 			Handler:    _SponsorService_CreateSponsor_Handler,
@@ -1985,27 +5419,113 @@ var _SponsorService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSponsor",
 			Handler:    _SponsorService_UpdateSponsor_Handler,
 		},
+		{
+			MethodName: "DeleteSponsor",
+			Handler:    _SponsorService_DeleteSponsor_Handler,
+		},
 		{
 			MethodName: "CreateCompany",
 			Handler:    _SponsorService_CreateCompany_Handler,
 		},
+		{
+			MethodName: "GetCompany",
+			Handler:    _SponsorService_GetCompany_Handler,
+		},
+		{
+			MethodName: "UpdateCompany",
+			Handler:    _SponsorService_UpdateCompany_Handler,
+		},
+		{
+			MethodName: "ListCompanySponsors",
+			Handler:    _SponsorService_ListCompanySponsors_Handler,
+		},
+		{
+			MethodName: "SetCompanyTier",
+			Handler:    _SponsorService_SetCompanyTier_Handler,
+		},
+		{
+			MethodName: "DeleteCompany",
+			Handler:    _SponsorService_DeleteCompany_Handler,
+		},
 		{
 			MethodName: "LoginSponsor",
 			Handler:    _SponsorService_LoginSponsor_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _SponsorService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _SponsorService_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _SponsorService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _SponsorService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _SponsorService_Logout_Handler,
+		},
 		{
 			MethodName: "Resumes",
 			Handler:    _SponsorService_Resumes_Handler,
 		},
+		{
+			MethodName: "GetResume",
+			Handler:    _SponsorService_GetResume_Handler,
+		},
+		{
+			MethodName: "AddToShortlist",
+			Handler:    _SponsorService_AddToShortlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromShortlist",
+			Handler:    _SponsorService_RemoveFromShortlist_Handler,
+		},
+		{
+			MethodName: "ListShortlist",
+			Handler:    _SponsorService_ListShortlist_Handler,
+		},
+		{
+			MethodName: "CreateNote",
+			Handler:    _SponsorService_CreateNote_Handler,
+		},
+		{
+			MethodName: "ListNotes",
+			Handler:    _SponsorService_ListNotes_Handler,
+		},
+		{
+			MethodName: "UpdateNote",
+			Handler:    _SponsorService_UpdateNote_Handler,
+		},
+		{
+			MethodName: "DeleteNote",
+			Handler:    _SponsorService_DeleteNote_Handler,
+		},
 		{
 			MethodName: "ListParticipants",
 			Handler:    _SponsorService_ListParticipants_Handler,
 		},
+		{
+			MethodName: "SearchParticipants",
+			Handler:    _SponsorService_SearchParticipants_Handler,
+		},
 		{
 			MethodName: "ListCompanies",
 			Handler:    _SponsorService_ListCompanies_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamResumes",
+			Handler:       _SponsorService_StreamResumes_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "sponsor.proto",
 }
@@ -5,6 +5,7 @@ import { User } from '../models/user.model';
 import { FormGroup, FormBuilder, Validators, FormControl, FormArray } from '@angular/forms';
 import { SponsorService } from '../services/sponsor/sponsor.service';
 import { Observable } from 'rxjs';
+import { SyncStatus } from '../models/sync-status.model';
 
 @Component({
   selector: 'app-admin',
@@ -15,13 +16,14 @@ export class AdminComponent implements OnInit {
 
   public user: User;
   public aclListMap = [
-    { id: 'read', name: 'Read' },
-    { id: 'write', name: 'Write' },
-    { id: 'download', name: 'Download' },
+    { id: 'sponsor-recruiter', name: 'Recruiter' },
+    { id: 'sponsor-manager', name: 'Manager' },
+    { id: 'read-only', name: 'Read only' },
   ]
   public addSponsorForm: FormGroup;
   public companies: Company[] = new Array<Company>();
   public showAddAlert: boolean = false;
+  public syncStatus: SyncStatus;
 
   constructor(
     public authService: AuthService,
@@ -31,13 +33,13 @@ export class AdminComponent implements OnInit {
     private sponsorService: SponsorService) { 
 
     let aclControls = this.aclListMap.map(c => new FormControl(false));
-    aclControls[0].setValue(true); // set read to always be true
+    aclControls[0].setValue(true); // sponsors are recruiters by default
     this.addSponsorForm = this.fb.group({
       companyId: [],
       aclListMap: new FormArray(aclControls),
       sponsorName: ['', Validators.required],
       sponsorEmail: ['', Validators.required],
-      sponsorPassword: ['', Validators.required],
+      sponsorPassword: [''], // sponsors without a password are emailed an invite
     });
   }
 
@@ -47,6 +49,7 @@ export class AdminComponent implements OnInit {
         this.router.navigate(['/login']);
       }
       this.user = this.authService.user();
+      this.loadSyncStatus();
       // Load the companies as soon as the component mounts on screen
       this.sponsorService.getCompanies().subscribe((data) => {
         if (!data['companies']) {
@@ -92,6 +95,25 @@ export class AdminComponent implements OnInit {
     }
   }
 
+  loadSyncStatus() {
+    this.sponsorService.getSyncStatus().subscribe(
+      (status: SyncStatus) => this.syncStatus = status,
+      (error: any) => console.log(error));
+  }
+
+  triggerSync(full: boolean) {
+    this.sponsorService.triggerSync(full).subscribe(
+      () => this.loadSyncStatus(),
+      (error: any) => console.log(error));
+  }
+
+  lastSyncTime(): Date {
+    if (!this.syncStatus || !this.syncStatus.lastFinish) {
+      return null;
+    }
+    return new Date(Number(this.syncStatus.lastFinish) * 1000);
+  }
+
   generateUniquePassword() {
     let randomPassword = Math.random().toString(36).slice(-8);
     this.addSponsorForm.controls['sponsorPassword'].setValue(randomPassword);
@@ -1,4 +1,4 @@
-import { HttpClientModule } from '@angular/common/http';
+import { HttpClientModule, HTTP_INTERCEPTORS } from '@angular/common/http';
 import { NgModule } from '@angular/core';
 import { FormsModule, ReactiveFormsModule } from '@angular/forms';
 import { BrowserModule } from '@angular/platform-browser';
@@ -10,9 +10,11 @@ import { AppComponent } from './app.component';
 import { HomeComponent } from './home/home.component';
 import { LoginComponent } from './login/login.component';
 import { AuthService } from './services/auth/auth.service';
+import { AuthInterceptor } from './services/auth/auth.interceptor';
 import { AdminComponent } from './admin/admin.component';
 import { SponsorService } from './services/sponsor/sponsor.service';
 import { UpdateProfileComponent } from './update-profile/update-profile.component';
+import { SetPasswordComponent } from './set-password/set-password.component';
 
 const appRoutes: Routes = [
   { path:'', component: HomeComponent },
@@ -21,6 +23,8 @@ const appRoutes: Routes = [
   { path: 'logout', redirectTo: '/login?action=logout'},
   { path: 'admin/:id', component: AdminComponent },
   { path: 'profile/:id/update', component: UpdateProfileComponent},
+  { path: 'invite', component: SetPasswordComponent, data: { purpose: 'invite' } },
+  { path: 'reset-password', component: SetPasswordComponent, data: { purpose: 'reset' } },
 ]
 
 @NgModule({
@@ -29,7 +33,8 @@ const appRoutes: Routes = [
     HomeComponent,
     LoginComponent,
     AdminComponent,
-    UpdateProfileComponent
+    UpdateProfileComponent,
+    SetPasswordComponent
   ],
   imports: [
     FormsModule,
@@ -43,7 +48,8 @@ const appRoutes: Routes = [
   ],
   providers: [
     AuthService,
-    SponsorService
+    SponsorService,
+    { provide: HTTP_INTERCEPTORS, useClass: AuthInterceptor, multi: true }
   ],
   bootstrap: [AppComponent]
 })
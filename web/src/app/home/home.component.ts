@@ -49,13 +49,34 @@ export class HomeComponent implements OnInit, AfterContentInit {
     this.participantService
         .list()
         .then((particiIn: Array<Participant>) => {
-          this.participants = particiIn.filter(p => p.name && p.resume);
+          this.participants = particiIn.filter(p => p.name && p.hasResume);
         }, 
         (reason) => {
           console.log(reason);
         });
   }
   
+  public openResume(p: Participant) {
+    // the window is opened right away since popup blockers only allow it
+    // during the click, it is pointed at the resume once the url is signed
+    const resumeWindow = window.open("", "_blank");
+    this.participantService
+        .resumeURL(p.id)
+        .then((url: string) => {
+          if (resumeWindow) {
+            resumeWindow.location.href = url;
+          } else {
+            window.location.href = url;
+          }
+        },
+        (reason) => {
+          if (resumeWindow) {
+            resumeWindow.close();
+          }
+          console.log(reason);
+        });
+  }
+
   public hasUser() {
     return this.user !== undefined;
   }
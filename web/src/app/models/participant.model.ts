@@ -7,5 +7,6 @@ export interface Participant {
     grad_year: string;
     github: string;
     resume: string;
+    hasResume: boolean;
     linkedin: string;
 }
@@ -0,0 +1,13 @@
+export interface SyncStatus {
+    source: string;
+    running: boolean;
+    full: boolean;
+    lastStart: string;
+    lastFinish: string;
+    durationMs: string;
+    saved: number;
+    mirrored: number;
+    deleted: number;
+    failures: string[];
+    lastError: string;
+}
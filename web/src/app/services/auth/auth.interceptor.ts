@@ -0,0 +1,41 @@
+import { Injectable } from '@angular/core';
+import { HttpErrorResponse, HttpEvent, HttpHandler, HttpInterceptor, HttpRequest } from '@angular/common/http';
+import { from, Observable, of, throwError } from 'rxjs';
+import { catchError, switchMap } from 'rxjs/operators';
+import { AuthService } from './auth.service';
+
+/**
+ * AuthInterceptor refreshes the access token before it expires and retries a
+ * request once with a new token when the server rejects the token with a 401
+ */
+@Injectable()
+export class AuthInterceptor implements HttpInterceptor {
+
+  constructor(private authService: AuthService) { }
+
+  intercept(req: HttpRequest<any>, next: HttpHandler): Observable<HttpEvent<any>> {
+    if (!req.headers.has("Authorization")) {
+      return next.handle(req);
+    }
+    if (this.authService.expiresSoon()) {
+      return from(this.authService.refresh()).pipe(
+        catchError(() => of(false)),
+        switchMap(() => next.handle(this.withToken(req))));
+    }
+    return next.handle(req).pipe(
+      catchError((error) => {
+        if (!(error instanceof HttpErrorResponse) || error.status != 401) {
+          return throwError(error);
+        }
+        return from(this.authService.refresh()).pipe(
+          catchError(() => throwError(error)),
+          switchMap(() => next.handle(this.withToken(req))));
+      }));
+  }
+
+  private withToken(req: HttpRequest<any>): HttpRequest<any> {
+    return req.clone({
+      headers: req.headers.set("Authorization", "Bearer " + this.authService.getToken())
+    });
+  }
+}
@@ -14,10 +14,19 @@ export class AuthService {
 
   private currentUser: Admin | Sponsor;
   private type: string;
+  // pendingRefresh is shared by all the callers that need a new token at the
+  // same time since a refresh token can only be used once
+  private pendingRefresh: Promise<boolean>;
 
   constructor(private http: HttpClient) { 
     if (this.isAuthenticated() && !this.currentUser) {
       this.loadCurrentUser();
+    } else if (localStorage.getItem("refresh_token")) {
+      // the refresh is deferred since the request goes through the
+      // AuthInterceptor which can only be created once this service is
+      Promise.resolve()
+        .then(() => this.refresh())
+        .then(() => this.loadCurrentUser(), () => {});
     }
   }
 
@@ -30,9 +39,60 @@ export class AuthService {
   }
 
   public logout() {
+    const refreshToken = localStorage.getItem("refresh_token");
+    if (this.isAuthenticated()) {
+      this.http.post(environment.apiBase + "/sponsor/logout", {"refresh_token": refreshToken},
+        { headers: new HttpHeaders().append("Authorization", "Bearer " + this.getToken())})
+        .toPromise()
+        .catch((reason) => console.log(reason));
+    }
     localStorage.clear();  
   }
 
+  /**
+   * refresh exchanges the refresh token for a new access token once the
+   * access token has expired or is about to expire
+   */
+  public refresh(): Promise<boolean> {
+    if (!this.pendingRefresh) {
+      this.pendingRefresh = this.refreshSession();
+      const done = () => this.pendingRefresh = null;
+      this.pendingRefresh.then(done, done);
+    }
+    return this.pendingRefresh;
+  }
+
+  private refreshSession(): Promise<boolean> {
+    return new Promise<boolean>((resolve, reject) => {
+      const refreshToken = localStorage.getItem("refresh_token");
+      if (!refreshToken) {
+        reject(false);
+        return;
+      }
+      this.http.post(environment.apiBase + "/sponsor/token/refresh", {"refresh_token": refreshToken},
+        { headers: new HttpHeaders().append("Content-Type", "application/json")})
+        .toPromise()
+        .then((data) => {
+          this.setSession(data['token'], data['refreshToken'], data['expiresAt']);
+          resolve(true);
+        },
+        (reason) => {
+          localStorage.clear();
+          this.currentUser = null;
+          reject(false);
+        });
+    });
+  }
+
+  /**
+   * expiresSoon reports whether the access token expires within the next
+   * minute and can be refreshed
+   */
+  public expiresSoon(): boolean {
+    return localStorage.getItem("refresh_token") != null &&
+      moment().add(1, 'minute').isSameOrAfter(this.getTokenExpiry());
+  }
+
   public isAuthenticated(): boolean {
     if (moment().isSameOrBefore(this.getTokenExpiry()) && this.getToken().length > 0) {
       return true;
@@ -49,7 +109,7 @@ export class AuthService {
           let admin = data['admin'] as Admin;
           admin.token = data['token'];
           this.setUser(admin, "Admin");
-          this.setSession(admin.token);
+          this.setSession(admin.token, data['refreshToken'], data['expiresAt']);
           resolve(admin);
         },
         (reason) => {
@@ -68,7 +128,7 @@ export class AuthService {
           let sp = data['sponsor'] as Sponsor;
           sp.token = data['token'];
           this.setUser(sp, "Sponsor");
-          this.setSession(sp.token);
+          this.setSession(sp.token, data['refreshToken'], data['expiresAt']);
           resolve(sp);
         },
         (reason) => {
@@ -77,6 +137,40 @@ export class AuthService {
     });
   }
 
+  /**
+   * acceptInvite sets the password of an invited sponsor with the token that
+   * was emailed to them
+   */
+  public acceptInvite(token: string, password: string): Promise<boolean> {
+    return this.postToken("/sponsor/invite/accept", {token, password});
+  }
+
+  /**
+   * requestPasswordReset emails a password reset link to the user with the
+   * email, it succeeds even when there is no such user
+   */
+  public requestPasswordReset(email: string): Promise<boolean> {
+    return this.postToken("/sponsor/password/reset/request", {email});
+  }
+
+  /**
+   * resetPassword sets a new password with the token that was emailed to the
+   * user
+   */
+  public resetPassword(token: string, password: string): Promise<boolean> {
+    return this.postToken("/sponsor/password/reset", {token, password});
+  }
+
+  private postToken(path: string, body: any): Promise<boolean> {
+    return new Promise<boolean>((resolve, reject) => {
+      this.http.post(environment.apiBase + path, body,
+        { headers: new HttpHeaders().append("Content-Type", "application/json")})
+        .toPromise()
+        .then((data) => resolve(true),
+        (reason) => reject(reason.error as Error));
+    });
+  }
+
   /**
    * validateUser sends a request to the server to check and see if the given token is still
    * valid
@@ -134,10 +228,18 @@ export class AuthService {
     return true;
   }
 
-  private setSession(token: string) {
-    const expiresIn = moment().add(1, "hour").toISOString();
+  private setSession(token: string, refreshToken: string, expiresAt: string) {
+    const expiresIn = moment.unix(Number(expiresAt)).toISOString();
     localStorage.setItem("token", token);
+    localStorage.setItem("refresh_token", refreshToken);
     localStorage.setItem("token_expires_at", expiresIn);
+    // the services send the token of the current user with every request
+    const savedUser = JSON.parse(localStorage.getItem("sess_user"));
+    if (savedUser) {
+      savedUser.obj.token = token;
+      localStorage.setItem("sess_user", JSON.stringify(savedUser));
+      this.loadCurrentUser();
+    }
   }
 
   private getTokenExpiry() {
@@ -145,7 +247,7 @@ export class AuthService {
     return moment(expiration);
   }
 
-  private getToken(): string {
-    return localStorage.getItem("token");
+  public getToken(): string {
+    return localStorage.getItem("token") || "";
   }
 }
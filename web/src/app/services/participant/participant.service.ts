@@ -1,6 +1,6 @@
 import { Injectable } from '@angular/core';
 import { Participant } from '../../models/participant.model';
-import { HttpClient, HttpHeaders } from '@angular/common/http';
+import { HttpClient, HttpHeaders, HttpParams } from '@angular/common/http';
 import { environment } from '../../../environments/environment';
 import { AuthService } from '../auth/auth.service';
 
@@ -12,19 +12,42 @@ export class ParticipantService {
   constructor(private http: HttpClient, private authService: AuthService) { }
 
   public list(): Promise<Array<Participant>> {
+    return this.listFrom("", new Array<Participant>());
+  }
+
+  // listFrom keeps following the nextPageToken until all the participants
+  // have been fetched from the server
+  private listFrom(pageToken: string, participants: Array<Participant>): Promise<Array<Participant>> {
     return new Promise<Array<Participant>>((resolve, reject) => {
       this.http.get(environment.apiBase + "/sponsor/participants", 
-          { headers: new HttpHeaders().append("Authorization", "Bearer " + this.authService.user().token)})
+          { headers: new HttpHeaders().append("Authorization", "Bearer " + this.authService.user().token),
+            params: new HttpParams().set("page_token", pageToken)})
           .toPromise()
           .then(
             (data) => {
-              let participants = new Array<Participant>();
-              for (let participant of data['participants']) {
+              for (let participant of data['participants'] || []) {
                 participants.push(participant as Participant);
               }
-              resolve(participants);
+              if (!data['nextPageToken']) {
+                resolve(participants);
+                return;
+              }
+              this.listFrom(data['nextPageToken'], participants).then(resolve, reject);
             },
             (reason) => reject(reason.error as Error))
     });
   }
+
+  // resumeURL returns a short lived signed url for the resume of a participant,
+  // the url can be opened without the Authorization header
+  public resumeURL(participantId: string): Promise<string> {
+    return new Promise<string>((resolve, reject) => {
+      this.http.get(environment.apiBase + "/sponsor/participants/" + participantId + "/resume",
+          { headers: new HttpHeaders().append("Authorization", "Bearer " + this.authService.user().token)})
+          .toPromise()
+          .then(
+            (data) => resolve(environment.apiBase.replace(/\/v1$/, "") + data['url']),
+            (reason) => reject(reason.error as Error))
+    });
+  }
 }
@@ -1,8 +1,10 @@
 import { Injectable } from '@angular/core';
 import { HttpClient, HttpHeaders } from '@angular/common/http';
 import { Observable } from 'rxjs';
+import { map } from 'rxjs/operators';
 import { environment } from '../../../environments/environment';
 import { AuthService } from '../auth/auth.service';
+import { SyncStatus } from '../../models/sync-status.model';
 
 @Injectable({
   providedIn: 'root'
@@ -33,6 +35,15 @@ export class SponsorService {
     return this.http.post<Sponsor>(environment.apiBase + "/sponsor", sponsorData, this.getHttpOptions());
   }
 
+  getSyncStatus(): Observable<SyncStatus> {
+    return this.http.get(environment.apiBase + "/sponsor/sync/status", this.getHttpOptions())
+      .pipe(map((data) => data['status'] as SyncStatus));
+  }
+
+  triggerSync(full: boolean): Observable<any> {
+    return this.http.post(environment.apiBase + "/sponsor/sync", { full: full }, this.getHttpOptions());
+  }
+
   private getHttpOptions() {
     return {
       headers: new HttpHeaders({
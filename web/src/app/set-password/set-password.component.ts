@@ -0,0 +1,70 @@
+import { Component, OnInit } from '@angular/core';
+import { FormBuilder, FormGroup, Validators } from '@angular/forms';
+import { ActivatedRoute, Router } from '@angular/router';
+import { AuthService } from '../services/auth/auth.service';
+import { Error } from '../models/error.model';
+
+/**
+ * SetPasswordComponent is the page the invite and password reset emails link
+ * to, it sets the password of the user with the token of the link. Without a
+ * token the reset page asks for the email to send the link to
+ */
+@Component({
+  selector: 'app-set-password',
+  templateUrl: './set-password.component.html',
+  styleUrls: ['./set-password.component.css']
+})
+export class SetPasswordComponent implements OnInit {
+  public invite: boolean = false;
+  public token: string = "";
+  public error: string = "";
+  public message: string = "";
+
+  public passwordForm: FormGroup;
+  public requestForm: FormGroup;
+
+  constructor(private fb: FormBuilder, private authService: AuthService, private router: Router,
+              private acRoute: ActivatedRoute) {
+    this.passwordForm = this.fb.group({
+      password: ['', [Validators.required, Validators.minLength(8)]],
+      confirmPassword: ['', Validators.required],
+    });
+    this.requestForm = this.fb.group({
+      email: ['', [Validators.required, Validators.email]],
+    });
+  }
+
+  ngOnInit() {
+    this.invite = this.acRoute.snapshot.data['purpose'] == "invite";
+    this.token = this.acRoute.snapshot.queryParams['token'] || "";
+  }
+
+  setPassword() {
+    const formValues = this.passwordForm.value;
+    if (formValues['password'] != formValues['confirmPassword']) {
+      this.error = "The passwords do not match";
+      return;
+    }
+    const done = this.invite ?
+      this.authService.acceptInvite(this.token, formValues['password']) :
+      this.authService.resetPassword(this.token, formValues['password']);
+    done.then(() => {
+        this.router.navigate(['/login']);
+      },
+      (reason: Error) => {
+        this.error = reason.message;
+      });
+  }
+
+  requestReset() {
+    this.authService
+      .requestPasswordReset(this.requestForm.value['email'])
+      .then(() => {
+        this.error = "";
+        this.message = "If there is an account with that email a link to reset its password has been sent.";
+      },
+      (reason: Error) => {
+        this.error = reason.message;
+      });
+  }
+}